@@ -0,0 +1,43 @@
+package casing_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go-study/my_practice/casing"
+	"go-study/my_practice/utils"
+)
+
+// Practice0_1이 보여주는 비교(string 이어붙이기 vs strings.Builder)를 길이별로 재고,
+// 같은 글을 casing.ToUpper와 strings.ToUpper로 바꾸는 비용도 나란히 잰다.
+// 이어붙이기는 매번 새 string을 만들어 복사하므로 길이의 제곱에 비례해서 느려진다.
+func BenchmarkToUpper(b *testing.B) {
+	funcs := []struct {
+		name string
+		f    func(string) string
+	}{
+		{"concat", utils.ToUpper1},
+		{"builder", utils.ToUpper2},
+		{"casing", func(s string) string { return casing.ToUpper(s) }},
+		{"strings", strings.ToUpper},
+	}
+	for _, t := range []struct{ name, text string }{
+		{"ascii", "Hello, World! "},
+		{"mixed", "Hello 월드! straße ΟΔΟΣ "},
+	} {
+		for _, n := range []int{64, 4096} {
+			s := strings.Repeat(t.text, n/len(t.text)+1)[:n]
+			s = strings.ToValidUTF8(s, "")
+			for _, f := range funcs {
+				b.Run(fmt.Sprintf("%s/%d/%s", t.name, n, f.name), func(b *testing.B) {
+					b.SetBytes(int64(len(s)))
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						f.f(s)
+					}
+				})
+			}
+		}
+	}
+}
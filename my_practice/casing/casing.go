@@ -0,0 +1,297 @@
+// Package casing converts the case of Unicode text.
+//
+// utils.ToUpper1 and ToUpper2 only map ASCII a–z. The functions here use
+// the full Unicode mappings: a rune may become several runes ("ß" → "SS",
+// "ﬁ" → "FI"), a capital sigma becomes "ς" at the end of a word and "σ"
+// elsewhere, and WithLanguage(Turkish) maps between dotted and dotless i
+// ("i" ↔ "İ", "ı" ↔ "I") instead of "i" ↔ "I".
+//
+// Split, ToSnake, ToKebab, ToCamel and ToPascal convert between identifier
+// styles on top of the same mappings.
+package casing
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Language selects language-specific casing rules.
+type Language int
+
+const (
+	// Default applies the language-independent Unicode rules.
+	Default Language = iota
+	// Turkish maps i ↔ İ and ı ↔ I.
+	Turkish
+	// Azeri uses the same i rules as Turkish.
+	Azeri
+)
+
+type options struct {
+	turkic bool
+}
+
+// Option configures a conversion.
+type Option func(*options)
+
+// WithLanguage applies the casing rules of l.
+func WithLanguage(l Language) Option {
+	return func(o *options) { o.turkic = l == Turkish || l == Azeri }
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// ToUpper returns s with every rune mapped to upper case.
+func ToUpper(s string, opts ...Option) string {
+	o := newOptions(opts)
+	if isASCII(s) && !o.turkic {
+		return asciiMap(s, 'a', 'z')
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		o.upper(&b, r)
+	}
+	return b.String()
+}
+
+// ToLower returns s with every rune mapped to lower case. A capital sigma
+// becomes "ς" when it ends a word and "σ" otherwise.
+func ToLower(s string, opts ...Option) string {
+	o := newOptions(opts)
+	if isASCII(s) && !o.turkic {
+		return asciiMap(s, 'A', 'Z')
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		i += o.lower(&b, s, i, r, w)
+	}
+	return b.String()
+}
+
+// ToTitle returns s with the first rune of every word mapped to title case
+// and the rest of the word to lower case, so "ǆungla ΟΔΟΣ" becomes
+// "ǅungla Οδος". A word is a run of letters, marks and digits; an
+// apostrophe between two letters does not end it ("don't" → "Don't").
+//
+// This is not strings.ToTitle, which maps every rune to title case.
+func ToTitle(s string, opts ...Option) string {
+	o := newOptions(opts)
+	var b strings.Builder
+	b.Grow(len(s))
+	inWord := false
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case !isWordRune(r) && !(inWord && isJoiner(r) && nextIsWordRune(s, i+w)):
+			inWord = false
+			b.WriteRune(r)
+		case !inWord:
+			inWord = true
+			o.title(&b, r)
+		default:
+			w = o.lower(&b, s, i, r, w)
+		}
+		i += w
+	}
+	return b.String()
+}
+
+// Fold returns the full case folding of s: two strings that differ only in
+// case, including "ß" and "SS" or "ς" and "Σ", fold to the same string.
+// With WithLanguage(Turkish), "I" folds to "ı" and "İ" to "i".
+func Fold(s string, opts ...Option) string {
+	o := newOptions(opts)
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		i += o.fold(&b, s, i, r, w)
+	}
+	return b.String()
+}
+
+// EqualFold reports whether s and t are equal under full case folding.
+// Unlike strings.EqualFold it considers "STRASSE" and "straße" equal.
+func EqualFold(s, t string, opts ...Option) bool {
+	return Fold(s, opts...) == Fold(t, opts...)
+}
+
+// per-rune mappings
+
+func (o options) upper(b *strings.Builder, r rune) {
+	switch {
+	case o.turkic && r == 'i':
+		b.WriteRune('İ')
+	default:
+		if m, ok := specialUpper[r]; ok {
+			b.WriteString(m)
+			return
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+}
+
+func (o options) title(b *strings.Builder, r rune) {
+	switch {
+	case o.turkic && r == 'i':
+		b.WriteRune('İ')
+	default:
+		if m, ok := specialTitle[r]; ok {
+			b.WriteString(m)
+			return
+		}
+		b.WriteRune(unicode.ToTitle(r))
+	}
+}
+
+// lower writes the lower case of r, which is the w bytes of s at i, and
+// returns how many bytes of s it consumed: w, or more when a following
+// combining dot is absorbed in Turkish.
+func (o options) lower(b *strings.Builder, s string, i int, r rune, w int) int {
+	switch {
+	case r == 'Σ':
+		if finalSigma(s, i, i+w) {
+			b.WriteRune('ς')
+		} else {
+			b.WriteRune('σ')
+		}
+	case o.turkic && r == 'I':
+		// "I" 뒤의 U+0307(윗점)은 "İ"를 분해해서 쓴 것이므로 점 있는 i가 된다
+		if strings.HasPrefix(s[i+w:], "\u0307") {
+			b.WriteRune('i')
+			return w + len("\u0307")
+		}
+		b.WriteRune('ı')
+	case o.turkic && r == 'İ':
+		b.WriteRune('i')
+	default:
+		if m, ok := specialLower[r]; ok {
+			b.WriteString(m)
+			return w
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return w
+}
+
+// fold is like lower: it folds r, the w bytes of s at i, and returns how
+// many bytes it consumed.
+func (o options) fold(b *strings.Builder, s string, i int, r rune, w int) int {
+	switch {
+	case o.turkic && r == 'I':
+		if strings.HasPrefix(s[i+w:], "\u0307") {
+			b.WriteRune('i')
+			return w + len("\u0307")
+		}
+		b.WriteRune('ı')
+	case o.turkic && r == 'İ':
+		b.WriteRune('i')
+	case r == 'ı':
+		// 대문자 I를 거치면 i가 되어버린다. ı는 fold해도 그대로다.
+		b.WriteRune('ı')
+	default:
+		if m, ok := specialFold[r]; ok {
+			b.WriteString(m)
+			return w
+		}
+		b.WriteRune(unicode.ToLower(unicode.ToUpper(r)))
+	}
+	return w
+}
+
+// finalSigma reports whether the capital sigma at s[i:j] ends a word: a
+// cased letter comes before it and none comes after it, ignoring
+// case-ignorable runes such as accents and apostrophes in between.
+func finalSigma(s string, i, j int) bool {
+	before := false
+	for k := i; k > 0; {
+		r, w := utf8.DecodeLastRuneInString(s[:k])
+		k -= w
+		if !caseIgnorable(r) {
+			before = isCased(r)
+			break
+		}
+	}
+	if !before {
+		return false
+	}
+	for k := j; k < len(s); {
+		r, w := utf8.DecodeRuneInString(s[k:])
+		k += w
+		if !caseIgnorable(r) {
+			return !isCased(r)
+		}
+	}
+	return true
+}
+
+// isCased is the Unicode Cased property.
+func isCased(r rune) bool {
+	return unicode.In(r, unicode.Upper, unicode.Lower, unicode.Title,
+		unicode.Other_Uppercase, unicode.Other_Lowercase)
+}
+
+// caseIgnorable approximates the Unicode Case_Ignorable property: marks,
+// format characters, modifiers and the punctuation that may sit inside a
+// word.
+func caseIgnorable(r rune) bool {
+	switch r {
+	case '\'', '.', ':', '^', '`', '·', '‘', '’', '․', '‧':
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+}
+
+// isWordRune also counts cased symbols such as "ⓐ", so ToTitle changes
+// every rune that ToUpper and ToLower do.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r) || isCased(r)
+}
+
+func isJoiner(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+func nextIsWordRune(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return i < len(s) && isWordRune(r)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// asciiMap flips the case of every byte of s in [lo, hi], which is a–z or
+// A–Z. It returns s itself when nothing changes, so already-cased input
+// costs no allocation.
+func asciiMap(s string, lo, hi byte) string {
+	i := 0
+	for i < len(s) && (s[i] < lo || s[i] > hi) {
+		i++
+	}
+	if i == len(s) {
+		return s
+	}
+	b := []byte(s)
+	for ; i < len(b); i++ {
+		if b[i] >= lo && b[i] <= hi {
+			b[i] ^= 'a' - 'A'
+		}
+	}
+	return string(b)
+}
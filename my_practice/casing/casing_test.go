@@ -0,0 +1,243 @@
+package casing
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+var tr = WithLanguage(Turkish)
+
+// caseTests는 기본 규칙과 Turkish 규칙의 결과를 나란히 적는다.
+// 결합 문자는 눈에 보이도록 \u로 적었다.
+var caseTests = []struct {
+	in             string
+	upper, trUpper string
+	lower, trLower string
+	title, trTitle string
+	fold, trFold   string
+}{
+	{"Hello 월드!",
+		"HELLO 월드!", "HELLO 월드!",
+		"hello 월드!", "hello 월드!",
+		"Hello 월드!", "Hello 월드!",
+		"hello 월드!", "hello 월드!"},
+	{"straße",
+		"STRASSE", "STRASSE",
+		"straße", "straße",
+		"Straße", "Straße",
+		"strasse", "strasse"},
+	{"ﬁre ﬂy",
+		"FIRE FLY", "FIRE FLY",
+		"ﬁre ﬂy", "ﬁre ﬂy",
+		"Fire Fly", "Fire Fly",
+		"fire fly", "fire fly"},
+	{"ΟΔΥΣΣΕΥΣ",
+		"ΟΔΥΣΣΕΥΣ", "ΟΔΥΣΣΕΥΣ",
+		"οδυσσευς", "οδυσσευς",
+		"Οδυσσευς", "Οδυσσευς",
+		"οδυσσευσ", "οδυσσευσ"},
+	{"ΣΑΣ. Σ ΑΣ'Β ΑΣ\u0301",
+		"ΣΑΣ. Σ ΑΣ'Β ΑΣ\u0301", "ΣΑΣ. Σ ΑΣ'Β ΑΣ\u0301",
+		"σας. σ ασ'β ας\u0301", "σας. σ ασ'β ας\u0301",
+		"Σας. Σ Ασ'β Ας\u0301", "Σας. Σ Ασ'β Ας\u0301",
+		"σασ. σ ασ'β ασ\u0301", "σασ. σ ασ'β ασ\u0301"},
+	{"ǆungla ǈudi",
+		"ǄUNGLA ǇUDI", "ǄUNGLA ǇUDİ",
+		"ǆungla ǉudi", "ǆungla ǉudi",
+		"ǅungla ǈudi", "ǅungla ǈudi",
+		"ǆungla ǉudi", "ǆungla ǉudi"},
+	{"İstanbul DİYARBAKIR ıi",
+		"İSTANBUL DİYARBAKIR II", "İSTANBUL DİYARBAKIR Iİ",
+		"i\u0307stanbul di\u0307yarbakir ıi", "istanbul diyarbakır ıi",
+		"İstanbul Di\u0307yarbakir Ii", "İstanbul Diyarbakır Ii",
+		"i\u0307stanbul di\u0307yarbakir ıi", "istanbul diyarbakır ıi"},
+	{"I\u0307STANBUL",
+		"I\u0307STANBUL", "I\u0307STANBUL",
+		"i\u0307stanbul", "istanbul",
+		"I\u0307stanbul", "I\u0307stanbul",
+		"i\u0307stanbul", "istanbul"},
+	{"ŉ ǰ ΐ ᾳ",
+		"ʼN J\u030C Ι\u0308\u0301 ΑΙ", "ʼN J\u030C Ι\u0308\u0301 ΑΙ",
+		"ŉ ǰ ΐ ᾳ", "ŉ ǰ ΐ ᾳ",
+		"ʼN J\u030C Ι\u0308\u0301 ᾼ", "ʼN J\u030C Ι\u0308\u0301 ᾼ",
+		"ʼn j\u030C ι\u0308\u0301 αι", "ʼn j\u030C ι\u0308\u0301 αι"},
+	{"don't STOP—rock’n’roll 1st",
+		"DON'T STOP—ROCK’N’ROLL 1ST", "DON'T STOP—ROCK’N’ROLL 1ST",
+		"don't stop—rock’n’roll 1st", "don't stop—rock’n’roll 1st",
+		"Don't Stop—Rock’n’roll 1st", "Don't Stop—Rock’n’roll 1st",
+		"don't stop—rock’n’roll 1st", "don't stop—rock’n’roll 1st"},
+	{"ⓐⓑⓒ",
+		"ⒶⒷⒸ", "ⒶⒷⒸ",
+		"ⓐⓑⓒ", "ⓐⓑⓒ",
+		"Ⓐⓑⓒ", "Ⓐⓑⓒ",
+		"ⓐⓑⓒ", "ⓐⓑⓒ"},
+}
+
+func testMapping(t *testing.T, name string, f func(string, ...Option) string, want func(i int) (string, string)) {
+	t.Helper()
+	for i, c := range caseTests {
+		def, turkish := want(i)
+		if got := f(c.in); got != def {
+			t.Errorf("%s(%q) = %q, want %q", name, c.in, got, def)
+		}
+		if got := f(c.in, tr); got != turkish {
+			t.Errorf("%s(%q, Turkish) = %q, want %q", name, c.in, got, turkish)
+		}
+	}
+}
+
+func TestToUpper(t *testing.T) {
+	testMapping(t, "ToUpper", ToUpper, func(i int) (string, string) {
+		return caseTests[i].upper, caseTests[i].trUpper
+	})
+}
+
+func TestToLower(t *testing.T) {
+	testMapping(t, "ToLower", ToLower, func(i int) (string, string) {
+		return caseTests[i].lower, caseTests[i].trLower
+	})
+}
+
+func TestToTitle(t *testing.T) {
+	testMapping(t, "ToTitle", ToTitle, func(i int) (string, string) {
+		return caseTests[i].title, caseTests[i].trTitle
+	})
+}
+
+func TestFold(t *testing.T) {
+	testMapping(t, "Fold", Fold, func(i int) (string, string) {
+		return caseTests[i].fold, caseTests[i].trFold
+	})
+}
+
+func TestEqualFold(t *testing.T) {
+	for _, c := range []struct {
+		s, t             string
+		want, tr, strEqF bool
+	}{
+		{"STRASSE", "straße", true, true, false},
+		{"ΣΟΦΟΣ", "σοφος", true, true, true},
+		{"Kelvin", "\u212Aelvin", true, true, true},
+		{"ﬁre", "FIRE", true, false, false},
+		{"DİYARBAKIR", "diyarbakır", false, true, false},
+		{"go", "Go!", false, false, false},
+	} {
+		if got := EqualFold(c.s, c.t); got != c.want {
+			t.Errorf("EqualFold(%q, %q) = %v, want %v", c.s, c.t, got, c.want)
+		}
+		if got := EqualFold(c.s, c.t, tr); got != c.tr {
+			t.Errorf("EqualFold(%q, %q, Turkish) = %v, want %v", c.s, c.t, got, c.tr)
+		}
+		if got := strings.EqualFold(c.s, c.t); got != c.strEqF {
+			t.Errorf("strings.EqualFold(%q, %q) = %v, want %v", c.s, c.t, got, c.strEqF)
+		}
+	}
+}
+
+// TestEveryRune compares the mapping of every rune with the unicode
+// package. They may only differ where tables.go has a multi-rune mapping.
+func TestEveryRune(t *testing.T) {
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		s := string(r)
+		for _, c := range []struct {
+			name  string
+			got   string
+			want  rune
+			table map[rune]string
+		}{
+			{"ToUpper", ToUpper(s), unicode.ToUpper(r), specialUpper},
+			{"ToLower", ToLower(s), unicode.ToLower(r), specialLower},
+			{"ToTitle", ToTitle(s), unicode.ToTitle(r), specialTitle},
+			{"Turkish ToUpper", ToUpper(s, tr), unicode.TurkishCase.ToUpper(r), specialUpper},
+			{"Turkish ToLower", ToLower(s, tr), unicode.TurkishCase.ToLower(r), specialLower},
+		} {
+			if c.got != string(c.want) && c.got != c.table[r] {
+				t.Errorf("%s(%U) = %q, want %q or %q from the table", c.name, r, c.got, c.want, c.table[r])
+			}
+		}
+
+		// strings.EqualFold로 같은 것은 EqualFold로도 같아야 한다
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if strings.EqualFold(s, string(f)) && !EqualFold(s, string(f)) {
+				t.Errorf("EqualFold(%q, %q) = false; Fold gives %q and %q", s, string(f), Fold(s), Fold(string(f)))
+			}
+		}
+	}
+
+	// 표의 mapping은 모두 쓰여야 한다
+	for _, c := range []struct {
+		name  string
+		f     func(string, ...Option) string
+		table map[rune]string
+	}{
+		{"upper", ToUpper, specialUpper},
+		{"lower", ToLower, specialLower},
+		{"title", ToTitle, specialTitle},
+	} {
+		for r, want := range c.table {
+			if got := c.f(string(r)); got != want {
+				t.Errorf("%s table entry %U = %q, but the function gives %q", c.name, r, want, got)
+			}
+		}
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	for _, c := range []struct {
+		in                                     string
+		split                                  []string
+		snake, kebab, camel, pascal, screaming string
+	}{
+		{"HTTPServerError", []string{"HTTP", "Server", "Error"},
+			"http_server_error", "http-server-error", "httpServerError", "HttpServerError", "HTTP_SERVER_ERROR"},
+		{"userID", []string{"user", "ID"},
+			"user_id", "user-id", "userId", "UserId", "USER_ID"},
+		{"utf8Decode", []string{"utf8", "Decode"},
+			"utf8_decode", "utf8-decode", "utf8Decode", "Utf8Decode", "UTF8_DECODE"},
+		{"http_server_error", []string{"http", "server", "error"},
+			"http_server_error", "http-server-error", "httpServerError", "HttpServerError", "HTTP_SERVER_ERROR"},
+		{"--max-retry  count--", []string{"max", "retry", "count"},
+			"max_retry_count", "max-retry-count", "maxRetryCount", "MaxRetryCount", "MAX_RETRY_COUNT"},
+		{"XMLHttpRequest2", []string{"XML", "Http", "Request2"},
+			"xml_http_request2", "xml-http-request2", "xmlHttpRequest2", "XmlHttpRequest2", "XML_HTTP_REQUEST2"},
+		{"ΣΟΦΟΣ_ΛΟΓΟΣ", []string{"ΣΟΦΟΣ", "ΛΟΓΟΣ"},
+			"σοφος_λογος", "σοφος-λογος", "σοφοςΛογος", "ΣοφοςΛογος", "ΣΟΦΟΣ_ΛΟΓΟΣ"},
+		{"ǅemalBey", []string{"ǅemal", "Bey"},
+			"ǆemal_bey", "ǆemal-bey", "ǆemalBey", "ǅemalBey", "ǄEMAL_BEY"},
+		{"월드Hello", []string{"월드", "Hello"},
+			"월드_hello", "월드-hello", "월드Hello", "월드Hello", "월드_HELLO"},
+		{"", nil, "", "", "", "", ""},
+		{"___", nil, "", "", "", "", ""},
+	} {
+		if got := Split(c.in); !reflect.DeepEqual(got, c.split) {
+			t.Errorf("Split(%q) = %q, want %q", c.in, got, c.split)
+		}
+		for _, f := range []struct {
+			name      string
+			got, want string
+		}{
+			{"ToSnake", ToSnake(c.in), c.snake},
+			{"ToKebab", ToKebab(c.in), c.kebab},
+			{"ToCamel", ToCamel(c.in), c.camel},
+			{"ToPascal", ToPascal(c.in), c.pascal},
+			{"ToScreamingSnake", ToScreamingSnake(c.in), c.screaming},
+		} {
+			if f.got != f.want {
+				t.Errorf("%s(%q) = %q, want %q", f.name, c.in, f.got, f.want)
+			}
+		}
+	}
+
+	if got := ToPascal("istanbul_ilçe", tr); got != "İstanbulİlçe" {
+		t.Errorf("ToPascal(istanbul_ilçe, Turkish) = %q", got)
+	}
+	if got := ToScreamingSnake("şehirİçi", tr); got != "ŞEHİR_İÇİ" {
+		t.Errorf("ToScreamingSnake(şehirİçi, Turkish) = %q", got)
+	}
+}
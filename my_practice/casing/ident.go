@@ -0,0 +1,107 @@
+package casing
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Identifier styles
+
+// "HTTPServerError", "http_server_error", "http-server-error"는 모두 같은
+// 세 단어 [HTTP Server Error]로 나뉜다. 나눈 다음에는 각 스타일대로 붙이기만 하면 된다.
+
+// Split breaks an identifier or phrase into words. Words end at any rune
+// that is not a letter or digit, where a lower-case letter or digit is
+// followed by an upper-case one ("userID" → "user", "ID"), and before the
+// last capital of a run of capitals followed by a lower-case letter
+// ("HTTPServer" → "HTTP", "Server"). Digits stay with the word before them
+// ("utf8Decode" → "utf8", "Decode").
+func Split(s string) []string {
+	var words []string
+	start := -1 // 지금 단어의 시작, 단어 밖이면 -1
+	var prev rune
+	for i, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+			if start >= 0 {
+				words = append(words, s[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start, prev = i, r
+			continue
+		}
+		if isUpper(r) {
+			switch {
+			case !isUpper(prev) && !unicode.IsMark(prev):
+				// fooBar, utf8Decode
+				words = append(words, s[start:i])
+				start = i
+			case isUpper(prev):
+				// HTTPServer: 다음이 소문자면 r부터 새 단어
+				if next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):]); unicode.IsLower(next) {
+					words = append(words, s[start:i])
+					start = i
+				}
+			}
+		}
+		prev = r
+	}
+	if start >= 0 {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+// isUpper counts title-case letters such as "ǅ" as upper case.
+func isUpper(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+// ToSnake returns the words of s in lower case joined by "_":
+// "HTTPServerError" → "http_server_error".
+func ToSnake(s string, opts ...Option) string {
+	return join(s, "_", opts, lowerWord, lowerWord)
+}
+
+// ToScreamingSnake returns the words of s in upper case joined by "_":
+// "maxRetryCount" → "MAX_RETRY_COUNT".
+func ToScreamingSnake(s string, opts ...Option) string {
+	return join(s, "_", opts, upperWord, upperWord)
+}
+
+// ToKebab returns the words of s in lower case joined by "-":
+// "HTTPServerError" → "http-server-error".
+func ToKebab(s string, opts ...Option) string {
+	return join(s, "-", opts, lowerWord, lowerWord)
+}
+
+// ToCamel returns the words of s joined with every word but the first
+// capitalized: "http_server_error" → "httpServerError".
+func ToCamel(s string, opts ...Option) string {
+	return join(s, "", opts, lowerWord, titleWord)
+}
+
+// ToPascal returns the words of s joined with every word capitalized:
+// "http_server_error" → "HttpServerError".
+func ToPascal(s string, opts ...Option) string {
+	return join(s, "", opts, titleWord, titleWord)
+}
+
+func join(s, sep string, opts []Option, first, rest func(string, []Option) string) string {
+	words := Split(s)
+	for i, w := range words {
+		if i == 0 {
+			words[i] = first(w, opts)
+		} else {
+			words[i] = rest(w, opts)
+		}
+	}
+	return strings.Join(words, sep)
+}
+
+func lowerWord(w string, opts []Option) string { return ToLower(w, opts...) }
+func upperWord(w string, opts []Option) string { return ToUpper(w, opts...) }
+func titleWord(w string, opts []Option) string { return ToTitle(w, opts...) }
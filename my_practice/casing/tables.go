@@ -0,0 +1,277 @@
+package casing
+
+// Multi-rune mappings that unicode.ToUpper, ToTitle and ToLower cannot
+// express because they map one rune to one rune. They are the
+// unconditional entries of SpecialCasing.txt and the full (status F)
+// entries of CaseFolding.txt from Unicode 14.0; everything not listed here
+// maps to a single rune and is left to the unicode package.
+//
+// The conditional rules (final sigma, Turkish and Azeri i) are in code,
+// not in these tables.
+
+var specialUpper = map[rune]string{
+	0x00DF: "SS",                 // LATIN SMALL LETTER SHARP S
+	0x0149: "\u02bcN",            // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x01F0: "J\u030c",            // LATIN SMALL LETTER J WITH CARON
+	0x0390: "\u0399\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03B0: "\u03a5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x0587: "\u0535\u0552",       // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x1E96: "H\u0331",            // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1E97: "T\u0308",            // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98: "W\u030a",            // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99: "Y\u030a",            // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1E9A: "A\u02be",            // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1F50: "\u03a5\u0313",       // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F52: "\u03a5\u0313\u0300", // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F54: "\u03a5\u0313\u0301", // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F56: "\u03a5\u0313\u0342", // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1F80: "\u1f08\u0399",       // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1F81: "\u1f09\u0399",       // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1F82: "\u1f0a\u0399",       // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F83: "\u1f0b\u0399",       // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F84: "\u1f0c\u0399",       // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F85: "\u1f0d\u0399",       // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F86: "\u1f0e\u0399",       // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F87: "\u1f0f\u0399",       // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F88: "\u1f08\u0399",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1F89: "\u1f09\u0399",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1F8A: "\u1f0a\u0399",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F8B: "\u1f0b\u0399",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F8C: "\u1f0c\u0399",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F8D: "\u1f0d\u0399",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F8E: "\u1f0e\u0399",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F8F: "\u1f0f\u0399",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F90: "\u1f28\u0399",       // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1F91: "\u1f29\u0399",       // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1F92: "\u1f2a\u0399",       // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F93: "\u1f2b\u0399",       // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F94: "\u1f2c\u0399",       // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F95: "\u1f2d\u0399",       // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F96: "\u1f2e\u0399",       // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F97: "\u1f2f\u0399",       // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F98: "\u1f28\u0399",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1F99: "\u1f29\u0399",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1F9A: "\u1f2a\u0399",       // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F9B: "\u1f2b\u0399",       // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F9C: "\u1f2c\u0399",       // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F9D: "\u1f2d\u0399",       // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F9E: "\u1f2e\u0399",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F9F: "\u1f2f\u0399",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FA0: "\u1f68\u0399",       // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1FA1: "\u1f69\u0399",       // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1FA2: "\u1f6a\u0399",       // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1FA3: "\u1f6b\u0399",       // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1FA4: "\u1f6c\u0399",       // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1FA5: "\u1f6d\u0399",       // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1FA6: "\u1f6e\u0399",       // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA7: "\u1f6f\u0399",       // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA8: "\u1f68\u0399",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1FA9: "\u1f69\u0399",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1FAA: "\u1f6a\u0399",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1FAB: "\u1f6b\u0399",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1FAC: "\u1f6c\u0399",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1FAD: "\u1f6d\u0399",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1FAE: "\u1f6e\u0399",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FAF: "\u1f6f\u0399",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FB2: "\u1fba\u0399",       // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB3: "\u0391\u0399",       // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1FB4: "\u0386\u0399",       // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6: "\u0391\u0342",       // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7: "\u0391\u0342\u0399", // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FBC: "\u0391\u0399",       // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1FC2: "\u1fca\u0399",       // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC3: "\u0397\u0399",       // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1FC4: "\u0389\u0399",       // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6: "\u0397\u0342",       // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7: "\u0397\u0342\u0399", // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FCC: "\u0397\u0399",       // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1FD2: "\u0399\u0308\u0300", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3: "\u0399\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6: "\u0399\u0342",       // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7: "\u0399\u0308\u0342", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FE2: "\u03a5\u0308\u0300", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3: "\u03a5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4: "\u03a1\u0313",       // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE6: "\u03a5\u0342",       // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7: "\u03a5\u0308\u0342", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FF2: "\u1ffa\u0399",       // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF3: "\u03a9\u0399",       // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1FF4: "\u038f\u0399",       // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6: "\u03a9\u0342",       // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7: "\u03a9\u0342\u0399", // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FFC: "\u03a9\u0399",       // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0xFB00: "FF",                 // LATIN SMALL LIGATURE FF
+	0xFB01: "FI",                 // LATIN SMALL LIGATURE FI
+	0xFB02: "FL",                 // LATIN SMALL LIGATURE FL
+	0xFB03: "FFI",                // LATIN SMALL LIGATURE FFI
+	0xFB04: "FFL",                // LATIN SMALL LIGATURE FFL
+	0xFB05: "ST",                 // LATIN SMALL LIGATURE LONG S T
+	0xFB06: "ST",                 // LATIN SMALL LIGATURE ST
+	0xFB13: "\u0544\u0546",       // ARMENIAN SMALL LIGATURE MEN NOW
+	0xFB14: "\u0544\u0535",       // ARMENIAN SMALL LIGATURE MEN ECH
+	0xFB15: "\u0544\u053b",       // ARMENIAN SMALL LIGATURE MEN INI
+	0xFB16: "\u054e\u0546",       // ARMENIAN SMALL LIGATURE VEW NOW
+	0xFB17: "\u0544\u053d",       // ARMENIAN SMALL LIGATURE MEN XEH
+}
+
+var specialTitle = map[rune]string{
+	0x00DF: "Ss",                 // LATIN SMALL LETTER SHARP S
+	0x0149: "\u02bcN",            // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x01F0: "J\u030c",            // LATIN SMALL LETTER J WITH CARON
+	0x0390: "\u0399\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03B0: "\u03a5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x0587: "\u0535\u0582",       // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x1E96: "H\u0331",            // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1E97: "T\u0308",            // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98: "W\u030a",            // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99: "Y\u030a",            // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1E9A: "A\u02be",            // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1F50: "\u03a5\u0313",       // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F52: "\u03a5\u0313\u0300", // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F54: "\u03a5\u0313\u0301", // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F56: "\u03a5\u0313\u0342", // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1FB2: "\u1fba\u0345",       // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB4: "\u0386\u0345",       // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6: "\u0391\u0342",       // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7: "\u0391\u0342\u0345", // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FC2: "\u1fca\u0345",       // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC4: "\u0389\u0345",       // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6: "\u0397\u0342",       // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7: "\u0397\u0342\u0345", // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FD2: "\u0399\u0308\u0300", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3: "\u0399\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6: "\u0399\u0342",       // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7: "\u0399\u0308\u0342", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FE2: "\u03a5\u0308\u0300", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3: "\u03a5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4: "\u03a1\u0313",       // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE6: "\u03a5\u0342",       // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7: "\u03a5\u0308\u0342", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FF2: "\u1ffa\u0345",       // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF4: "\u038f\u0345",       // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6: "\u03a9\u0342",       // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7: "\u03a9\u0342\u0345", // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0xFB00: "Ff",                 // LATIN SMALL LIGATURE FF
+	0xFB01: "Fi",                 // LATIN SMALL LIGATURE FI
+	0xFB02: "Fl",                 // LATIN SMALL LIGATURE FL
+	0xFB03: "Ffi",                // LATIN SMALL LIGATURE FFI
+	0xFB04: "Ffl",                // LATIN SMALL LIGATURE FFL
+	0xFB05: "St",                 // LATIN SMALL LIGATURE LONG S T
+	0xFB06: "St",                 // LATIN SMALL LIGATURE ST
+	0xFB13: "\u0544\u0576",       // ARMENIAN SMALL LIGATURE MEN NOW
+	0xFB14: "\u0544\u0565",       // ARMENIAN SMALL LIGATURE MEN ECH
+	0xFB15: "\u0544\u056b",       // ARMENIAN SMALL LIGATURE MEN INI
+	0xFB16: "\u054e\u0576",       // ARMENIAN SMALL LIGATURE VEW NOW
+	0xFB17: "\u0544\u056d",       // ARMENIAN SMALL LIGATURE MEN XEH
+}
+
+var specialLower = map[rune]string{
+	0x0130: "i\u0307", // LATIN CAPITAL LETTER I WITH DOT ABOVE
+}
+
+var specialFold = map[rune]string{
+	0x00DF: "ss",                 // LATIN SMALL LETTER SHARP S
+	0x0130: "i\u0307",            // LATIN CAPITAL LETTER I WITH DOT ABOVE
+	0x0149: "\u02bcn",            // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x01F0: "j\u030c",            // LATIN SMALL LETTER J WITH CARON
+	0x0390: "\u03b9\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03B0: "\u03c5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x0587: "\u0565\u0582",       // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x1E96: "h\u0331",            // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1E97: "t\u0308",            // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98: "w\u030a",            // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99: "y\u030a",            // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1E9A: "a\u02be",            // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1E9E: "ss",                 // LATIN CAPITAL LETTER SHARP S
+	0x1F50: "\u03c5\u0313",       // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F52: "\u03c5\u0313\u0300", // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F54: "\u03c5\u0313\u0301", // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F56: "\u03c5\u0313\u0342", // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1F80: "\u1f00\u03b9",       // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1F81: "\u1f01\u03b9",       // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1F82: "\u1f02\u03b9",       // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F83: "\u1f03\u03b9",       // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F84: "\u1f04\u03b9",       // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F85: "\u1f05\u03b9",       // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F86: "\u1f06\u03b9",       // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F87: "\u1f07\u03b9",       // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F88: "\u1f00\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1F89: "\u1f01\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1F8A: "\u1f02\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F8B: "\u1f03\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F8C: "\u1f04\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F8D: "\u1f05\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F8E: "\u1f06\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F8F: "\u1f07\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F90: "\u1f20\u03b9",       // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1F91: "\u1f21\u03b9",       // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1F92: "\u1f22\u03b9",       // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F93: "\u1f23\u03b9",       // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F94: "\u1f24\u03b9",       // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F95: "\u1f25\u03b9",       // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F96: "\u1f26\u03b9",       // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F97: "\u1f27\u03b9",       // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F98: "\u1f20\u03b9",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1F99: "\u1f21\u03b9",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1F9A: "\u1f22\u03b9",       // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F9B: "\u1f23\u03b9",       // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F9C: "\u1f24\u03b9",       // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F9D: "\u1f25\u03b9",       // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F9E: "\u1f26\u03b9",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F9F: "\u1f27\u03b9",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FA0: "\u1f60\u03b9",       // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1FA1: "\u1f61\u03b9",       // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1FA2: "\u1f62\u03b9",       // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1FA3: "\u1f63\u03b9",       // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1FA4: "\u1f64\u03b9",       // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1FA5: "\u1f65\u03b9",       // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1FA6: "\u1f66\u03b9",       // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA7: "\u1f67\u03b9",       // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA8: "\u1f60\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1FA9: "\u1f61\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1FAA: "\u1f62\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1FAB: "\u1f63\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1FAC: "\u1f64\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1FAD: "\u1f65\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1FAE: "\u1f66\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FAF: "\u1f67\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FB2: "\u1f70\u03b9",       // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB3: "\u03b1\u03b9",       // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1FB4: "\u03ac\u03b9",       // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6: "\u03b1\u0342",       // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7: "\u03b1\u0342\u03b9", // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FBC: "\u03b1\u03b9",       // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1FC2: "\u1f74\u03b9",       // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC3: "\u03b7\u03b9",       // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1FC4: "\u03ae\u03b9",       // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6: "\u03b7\u0342",       // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7: "\u03b7\u0342\u03b9", // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FCC: "\u03b7\u03b9",       // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1FD2: "\u03b9\u0308\u0300", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3: "\u03b9\u0308\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6: "\u03b9\u0342",       // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7: "\u03b9\u0308\u0342", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FE2: "\u03c5\u0308\u0300", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3: "\u03c5\u0308\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4: "\u03c1\u0313",       // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE6: "\u03c5\u0342",       // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7: "\u03c5\u0308\u0342", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FF2: "\u1f7c\u03b9",       // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF3: "\u03c9\u03b9",       // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1FF4: "\u03ce\u03b9",       // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6: "\u03c9\u0342",       // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7: "\u03c9\u0342\u03b9", // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FFC: "\u03c9\u03b9",       // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0xFB00: "ff",                 // LATIN SMALL LIGATURE FF
+	0xFB01: "fi",                 // LATIN SMALL LIGATURE FI
+	0xFB02: "fl",                 // LATIN SMALL LIGATURE FL
+	0xFB03: "ffi",                // LATIN SMALL LIGATURE FFI
+	0xFB04: "ffl",                // LATIN SMALL LIGATURE FFL
+	0xFB05: "st",                 // LATIN SMALL LIGATURE LONG S T
+	0xFB06: "st",                 // LATIN SMALL LIGATURE ST
+	0xFB13: "\u0574\u0576",       // ARMENIAN SMALL LIGATURE MEN NOW
+	0xFB14: "\u0574\u0565",       // ARMENIAN SMALL LIGATURE MEN ECH
+	0xFB15: "\u0574\u056b",       // ARMENIAN SMALL LIGATURE MEN INI
+	0xFB16: "\u057e\u0576",       // ARMENIAN SMALL LIGATURE VEW NOW
+	0xFB17: "\u0574\u056d",       // ARMENIAN SMALL LIGATURE MEN XEH
+}
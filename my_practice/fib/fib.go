@@ -0,0 +1,72 @@
+// Package fib computes single Fibonacci and Lucas numbers directly, without
+// generating every term before them as the fibonacci() closure in
+// utils/practice3.go does.
+//
+// Big returns F(n) as a *big.Int for any n, so it does not overflow after
+// F(92) like an int. Mod and ModBig return F(n) mod m using only uint64
+// arithmetic, and Pisano returns the period of the sequence mod m.
+//
+// Everything is built on fast doubling: from F(k) and F(k+1),
+//
+//	F(2k)   = F(k) * (2*F(k+1) - F(k))
+//	F(2k+1) = F(k)^2 + F(k+1)^2
+//
+// so F(n) takes one doubling step per bit of n, O(log n) multiplications.
+// Negative n follows F(-n) = (-1)^(n+1) * F(n).
+package fib
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Big returns F(n), the nth Fibonacci number: F(0) = 0, F(1) = 1 and
+// F(n) = F(n-1) + F(n-2).
+func Big(n int) *big.Int {
+	f, _ := Pair(n)
+	return f
+}
+
+// Pair returns F(n) and F(n+1).
+func Pair(n int) (f, f1 *big.Int) {
+	if n >= 0 {
+		return pair(uint(n))
+	}
+	// F(n) = F(n+2) - F(n+1)를 거꾸로 쓰면 음수 쪽으로 이어진다:
+	// F(-k) = (-1)^(k+1) F(k)
+	k := uint(-n)
+	fk1, fk := pair(k - 1) // F(k-1), F(k)
+	if k%2 == 0 {
+		fk.Neg(fk)
+	} else {
+		fk1.Neg(fk1)
+	}
+	return fk, fk1 // F(-k), F(-k+1) = F(-(k-1))
+}
+
+// pair returns F(n) and F(n+1) by fast doubling, reading the bits of n
+// from the top.
+func pair(n uint) (*big.Int, *big.Int) {
+	a, b := big.NewInt(0), big.NewInt(1) // F(k), F(k+1), k = 0
+	t, u := new(big.Int), new(big.Int)
+	for i := bits.Len(n) - 1; i >= 0; i-- {
+		// k → 2k
+		t.Lsh(b, 1).Sub(t, a).Mul(t, a) // F(2k) = F(k)(2F(k+1) - F(k))
+		u.Mul(b, b)
+		b.Mul(a, a).Add(b, u) // F(2k+1) = F(k)² + F(k+1)²
+		a, t = t, a
+		if n>>uint(i)&1 == 1 {
+			// 2k → 2k+1
+			a.Add(a, b)
+			a, b = b, a
+		}
+	}
+	return a, b
+}
+
+// Lucas returns L(n), the nth Lucas number: L(0) = 2, L(1) = 1 and
+// L(n) = L(n-1) + L(n-2). It is computed as L(n) = 2*F(n+1) - F(n).
+func Lucas(n int) *big.Int {
+	f, f1 := Pair(n)
+	return f1.Lsh(f1, 1).Sub(f1, f)
+}
@@ -0,0 +1,146 @@
+package fib
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestBig(t *testing.T) {
+	fs := []int64{-21, 13, -8, 5, -3, 2, -1, 1, 0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144}
+	ls := []int64{47, -29, 18, -11, 7, -4, 3, -1, 2, 1, 3, 4, 7, 11, 18, 29, 47, 76, 123, 199, 322}
+	for i, n := 0, -8; n <= 12; i, n = i+1, n+1 {
+		if got := Big(n); got.Int64() != fs[i] {
+			t.Errorf("Big(%d) = %v, want %d", n, got, fs[i])
+		}
+		if got := Lucas(n); got.Int64() != ls[i] {
+			t.Errorf("Lucas(%d) = %v, want %d", n, got, ls[i])
+		}
+	}
+
+	for _, c := range []struct {
+		f    func(int) *big.Int
+		name string
+		n    int
+		want string
+	}{
+		{Big, "Big", 92, "7540113804746346429"},
+		{Big, "Big", 93, "12200160415121876738"}, // int64에 들어가지 않는 첫 값
+		{Big, "Big", 100, "354224848179261915075"},
+		{Lucas, "Lucas", 100, "792070839848372253127"},
+	} {
+		if got := c.f(c.n).String(); got != c.want {
+			t.Errorf("%s(%d) = %s, want %s", c.name, c.n, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		n              int
+		digits         int
+		prefix, suffix string
+	}{
+		{1000, 209, "4346655768", "6849228875"},
+		{100000, 20899, "2597406934", "3428746875"},
+		{1000000, 208988, "1953282128", "8242546875"},
+	} {
+		s := Big(c.n).String()
+		if len(s) != c.digits || s[:10] != c.prefix || s[len(s)-10:] != c.suffix {
+			t.Errorf("Big(%d) = %s...%s with %d digits, want %s...%s with %d",
+				c.n, s[:10], s[len(s)-10:], len(s), c.prefix, c.suffix, c.digits)
+		}
+	}
+}
+
+// 한 항씩 더해서 만든 값과 비교한다.
+func TestBigIterate(t *testing.T) {
+	for n := 0; n <= 2000; n++ {
+		if got, want := Big(n), fibIterate(n); got.Cmp(want) != 0 {
+			t.Fatalf("Big(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+// fibIterate is utils' fibonacci() closure with big.Int, so it does not overflow.
+func fibIterate(n int) *big.Int {
+	prev, cur := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		prev.Add(prev, cur)
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// 항등식. 음수 n에서도 성립해야 한다.
+func TestIdentities(t *testing.T) {
+	var (
+		one, two, four, five = big.NewInt(1), big.NewInt(2), big.NewInt(4), big.NewInt(5)
+		sign                 = func(n int) *big.Int { // (-1)^n
+			if n%2 == 0 {
+				return one
+			}
+			return big.NewInt(-1)
+		}
+		mul = func(a, b *big.Int) *big.Int { return new(big.Int).Mul(a, b) }
+		sub = func(a, b *big.Int) *big.Int { return new(big.Int).Sub(a, b) }
+		add = func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) }
+	)
+	check := func(name string, n int, got, want *big.Int) {
+		t.Helper()
+		if got.Cmp(want) != 0 {
+			t.Errorf("%s, n = %d: %v != %v", name, n, got, want)
+		}
+	}
+
+	var ns []int
+	for n := -300; n <= 300; n++ {
+		ns = append(ns, n)
+	}
+	ns = append(ns, 1000, 4095, 4096, 12345, -12345)
+	for _, n := range ns {
+		f, f1, l := Big(n), Big(n+1), Lucas(n)
+		check("F(n+1) = F(n) + F(n-1)", n, f1, add(f, Big(n-1)))
+		check("F(2n) = F(n)(2F(n+1) - F(n))", n, Big(2*n), mul(f, sub(mul(two, f1), f)))
+		check("F(2n+1) = F(n)² + F(n+1)²", n, Big(2*n+1), add(mul(f, f), mul(f1, f1)))
+		check("F(n-1)F(n+1) - F(n)² = (-1)^n", n, sub(mul(Big(n-1), f1), mul(f, f)), sign(n))
+		check("F(-n) = (-1)^(n+1) F(n)", n, Big(-n), mul(sign(n+1), f))
+		check("L(n) = F(n-1) + F(n+1)", n, l, add(Big(n-1), f1))
+		check("L(n)² - 5F(n)² = 4(-1)^n", n, sub(mul(l, l), mul(five, mul(f, f))), mul(four, sign(n)))
+		check("F(2n) = F(n)L(n)", n, Big(2*n), mul(f, l))
+		a, b := Pair(n)
+		check("Pair(n) first", n, a, f)
+		check("Pair(n) second", n, b, f1)
+	}
+
+	for m := 1; m <= 60; m++ {
+		for n := 1; n <= 60; n++ {
+			name := fmt.Sprintf("F(m+n) = F(m)F(n+1) + F(m-1)F(n), m = %d", m)
+			check(name, n, Big(m+n), add(mul(Big(m), Big(n+1)), mul(Big(m-1), Big(n))))
+			name = fmt.Sprintf("gcd(F(m), F(n)) = F(gcd(m, n)), m = %d", m)
+			check(name, n, new(big.Int).GCD(nil, nil, Big(m), Big(n)), Big(gcdInt(m, n)))
+		}
+	}
+}
+
+func gcdInt(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func BenchmarkBig(b *testing.B) {
+	for _, n := range []int{90, 1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("%d/iterate", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				fibIterate(n)
+			}
+		})
+		b.Run(fmt.Sprintf("%d/doubling", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Big(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package fib
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// Modulo m
+
+// F(n) mod m는 big.Int 없이 fast doubling을 그대로 mod m에서 하면 된다.
+// 곱셈은 bits.Mul64로 128 bit를 만든 다음 나머지를 구하므로 m이 커도 넘치지 않는다.
+
+// MaxPisanoModulus is the largest modulus Pisano accepts. Pisano factors m
+// by trial division, which stays fast up to here.
+const MaxPisanoModulus = 1 << 32
+
+// ErrModulus is returned by Pisano for a modulus of 0 or one larger than
+// MaxPisanoModulus.
+var ErrModulus = errors.New("fib: modulus out of range")
+
+// Mod returns F(n) mod m. It panics if m is 0.
+func Mod(n, m uint64) uint64 {
+	f, _ := modPair64(n, m)
+	return f
+}
+
+// ModBig returns F(n) mod m, in [0, m), for an n of any size, including
+// negative n. It panics if m is 0.
+//
+// Because F(n) mod m repeats with period Pisano(m), ModBig(n, m) equals
+// Mod(n mod Pisano(m), m); ModBig does not need the period, as the number
+// of steps already grows only with the number of bits of n.
+func ModBig(n *big.Int, m uint64) uint64 {
+	abs := new(big.Int).Abs(n)
+	f, _ := modPair(abs.BitLen(), abs.Bit, m)
+	if n.Sign() < 0 && abs.Bit(0) == 0 && f != 0 {
+		// F(-k) = -F(k) for even k
+		f = m - f
+	}
+	return f
+}
+
+// modPair returns F(k) and F(k+1) mod m for the k whose bits, lowest
+// first, are bit(0) .. bit(n-1).
+func modPair(n int, bit func(int) uint, m uint64) (uint64, uint64) {
+	if m == 0 {
+		panic("fib: modulus 0")
+	}
+	a, b := uint64(0), 1%m
+	for i := n - 1; i >= 0; i-- {
+		c := mulMod(a, subMod(addMod(b, b, m), a, m), m) // F(2k)
+		d := addMod(mulMod(a, a, m), mulMod(b, b, m), m) // F(2k+1)
+		a, b = c, d
+		if bit(i) == 1 {
+			a, b = b, addMod(a, b, m)
+		}
+	}
+	return a, b
+}
+
+func modPair64(n, m uint64) (uint64, uint64) {
+	return modPair(bits.Len64(n), func(i int) uint { return uint(n>>uint(i)) & 1 }, m)
+}
+
+// a, b < m
+func addMod(a, b, m uint64) uint64 {
+	s, carry := bits.Add64(a, b, 0)
+	if carry != 0 || s >= m {
+		s -= m
+	}
+	return s
+}
+
+func subMod(a, b, m uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+	return a + (m - b)
+}
+
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// Pisano returns the Pisano period π(m), the length of the cycle that
+// F(n) mod m repeats: π(2) = 3 (0, 1, 1), π(10) = 60.
+//
+// It factors m and combines the periods of its prime powers with
+// π(p^k) = p^(k-1) π(p) and π(ab) = lcm(π(a), π(b)) for coprime a, b.
+// The first rule is Wall's conjecture; it is unproven but holds for every
+// prime anyone has checked, far beyond MaxPisanoModulus.
+func Pisano(m uint64) (uint64, error) {
+	if m == 0 || m > MaxPisanoModulus {
+		return 0, fmt.Errorf("%w: %d", ErrModulus, m)
+	}
+	period := uint64(1)
+	for _, f := range factor(m) {
+		pk := pisanoPrime(f.p)
+		for i := 1; i < f.k; i++ {
+			pk *= f.p
+		}
+		period = lcm(period, pk)
+	}
+	return period, nil
+}
+
+// pisanoPrime returns π(p) for a prime p.
+func pisanoPrime(p uint64) uint64 {
+	// p ≡ ±1 (mod 10)이면 π(p)는 p-1의 약수이고, p ≡ ±3 (mod 10)이면 2(p+1)의 약수다.
+	var c uint64
+	switch p % 10 {
+	case 1, 9:
+		c = p - 1
+	case 3, 7:
+		c = 2 * (p + 1)
+	case 2:
+		return 3
+	default: // 5
+		return 20
+	}
+	// period의 배수만 다시 (0, 1)로 돌아오므로, 소인수를 하나씩 빼 보며 줄인다
+	for _, f := range factor(c) {
+		for c%f.p == 0 && isPeriod(c/f.p, p) {
+			c /= f.p
+		}
+	}
+	return c
+}
+
+func isPeriod(n, m uint64) bool {
+	f, f1 := modPair64(n, m)
+	return f == 0 && f1 == 1%m
+}
+
+type primePower struct {
+	p uint64
+	k int
+}
+
+// factor returns the prime factorization of n by trial division.
+func factor(n uint64) []primePower {
+	var fs []primePower
+	for p := uint64(2); p*p <= n; p++ {
+		if n%p != 0 {
+			continue
+		}
+		f := primePower{p, 0}
+		for n%p == 0 {
+			n /= p
+			f.k++
+		}
+		fs = append(fs, f)
+	}
+	if n > 1 {
+		fs = append(fs, primePower{n, 1})
+	}
+	return fs
+}
+
+func lcm(a, b uint64) uint64 {
+	x, y := a, b
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return a / x * b
+}
@@ -0,0 +1,112 @@
+package fib
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestMod(t *testing.T) {
+	moduli := []uint64{1, 2, 3, 10, 97, 1000, 1 << 32, 1e9 + 7, 1<<64 - 59, 1<<64 - 1}
+	for _, m := range moduli {
+		bm := new(big.Int).SetUint64(m)
+		for n := -200; n <= 1000; n++ {
+			want := new(big.Int).Mod(Big(n), bm).Uint64()
+			if got := ModBig(big.NewInt(int64(n)), m); got != want {
+				t.Errorf("ModBig(%d, %d) = %d, want %d", n, m, got, want)
+			}
+			if n < 0 {
+				continue
+			}
+			if got := Mod(uint64(n), m); got != want {
+				t.Errorf("Mod(%d, %d) = %d, want %d", n, m, got, want)
+			}
+		}
+	}
+
+	if got := Mod(1<<64-1, 1<<64-59); got != 18446743708274255395 {
+		t.Errorf("Mod(2^64-1, 2^64-59) = %d, want 18446743708274255395", got)
+	}
+
+	// F(10^100) mod 10^9는 Pisano 주기로 n을 줄여서 구한 것과 같아야 한다
+	googol := new(big.Int).Exp(big.NewInt(10), big.NewInt(100), nil)
+	p, err := Pisano(1e9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reduced := new(big.Int).Mod(googol, new(big.Int).SetUint64(p)).Uint64()
+	if got, viaPisano := ModBig(googol, 1e9), Mod(reduced, 1e9); got != 560546875 || viaPisano != 560546875 {
+		t.Errorf("F(10^100) mod 10^9 = %d, via Pisano %d, want 560546875", got, viaPisano)
+	}
+}
+
+func TestModZero(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "fib: modulus 0" {
+			t.Errorf("Mod(5, 0) panicked with %v", r)
+		}
+	}()
+	Mod(5, 0)
+	t.Error("Mod(5, 0) did not panic")
+}
+
+func TestPisano(t *testing.T) {
+	for _, c := range []struct{ m, want uint64 }{
+		{1, 1},
+		{2, 3},
+		{3, 8},
+		{5, 20},
+		{10, 60},
+		{100, 300},
+		{1000, 1500},
+		{1e9, 1500000000},
+		{1e9 + 7, 2000000016},
+		{998244353, 1996488708},
+		{1 << 32, 6442450944},
+	} {
+		if got, err := Pisano(c.m); got != c.want || err != nil {
+			t.Errorf("Pisano(%d) = %d, %v, want %d", c.m, got, err, c.want)
+		}
+	}
+
+	for m := uint64(1); m <= 2000; m++ {
+		if got, err := Pisano(m); got != pisanoBrute(m) || err != nil {
+			t.Errorf("Pisano(%d) = %d, %v, want %d", m, got, err, pisanoBrute(m))
+		}
+	}
+
+	// F(n + π(m)) ≡ F(n) (mod m)
+	for _, m := range []uint64{1e9 + 7, 998244353, 1 << 32, 4294967291} {
+		p, err := Pisano(m)
+		if err != nil {
+			t.Errorf("Pisano(%d): %v", m, err)
+			continue
+		}
+		if Mod(p, m) != 0 || Mod(p+1, m) != 1 || Mod(12345+p, m) != Mod(12345, m) {
+			t.Errorf("Pisano(%d) = %d is not a period", m, p)
+		}
+	}
+
+	for _, m := range []uint64{0, 1<<32 + 1} {
+		if _, err := Pisano(m); !errors.Is(err, ErrModulus) {
+			t.Errorf("Pisano(%d): err = %v, want ErrModulus", m, err)
+		}
+	}
+}
+
+// pisanoBrute finds π(m) by walking the sequence until 0, 1 comes back.
+func pisanoBrute(m uint64) uint64 {
+	a, b := uint64(0), 1%m
+	for i := uint64(1); ; i++ {
+		a, b = b, (a+b)%m
+		if a == 0 && b == 1%m {
+			return i
+		}
+	}
+}
+
+func BenchmarkMod(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Mod(1e18, 1e9+7)
+	}
+}
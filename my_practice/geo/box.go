@@ -0,0 +1,100 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ErrNoPoints is returned by Bound when it is given no points.
+var ErrNoPoints = errors.New("geo: bounding box of no points")
+
+// Box is the area between two parallels and two meridians. It runs east
+// from West to East, so West > East means it crosses the 180° meridian,
+// as a box around Fiji does.
+type Box struct {
+	South, West, North, East float64
+}
+
+// Bound returns the smallest Box that contains points. It returns
+// ErrNoPoints if there are none.
+func Bound(points ...LatLng) (Box, error) {
+	if len(points) == 0 {
+		return Box{}, ErrNoPoints
+	}
+	b := Box{South: 90, North: -90}
+	longs := make([]float64, len(points))
+	for i, p := range points {
+		b.South = math.Min(b.South, p.Lat)
+		b.North = math.Max(b.North, p.Lat)
+		longs[i] = normalizeLong(p.Long)
+	}
+	// 경도는 원을 이루므로 "가장 작은 값부터 가장 큰 값까지"가 가장 좁은 범위라는 보장이 없다.
+	// 점들 사이의 가장 넓은 빈틈을 뺀 나머지가 가장 좁은 범위다.
+	sort.Float64s(longs)
+	gap, after := longs[0]+360-longs[len(longs)-1], 0 // 180°를 지나는 빈틈
+	for i := 1; i < len(longs); i++ {
+		if d := longs[i] - longs[i-1]; d > gap {
+			gap, after = d, i
+		}
+	}
+	b.West = longs[after]
+	b.East = longs[(after+len(longs)-1)%len(longs)]
+	return b, nil
+}
+
+// Around returns a Box that contains every point within km kilometres of p
+// on the sphere. It is a little larger than the circle, so a search can
+// use it to skip points quickly and then check Distance on the rest. Near
+// a pole it covers all longitudes.
+func (p LatLng) Around(km float64) Box {
+	// 위도 방향은 km/R 라디안 그대로. 경도 방향은 원에 접하는 두 자오선까지의 각도인데,
+	// 그 값은 p의 위도가 아니라 접점의 위도에서 정해지므로 asin(sin δ / cos φ)가 된다.
+	δ := math.Max(0, km) / EarthRadius
+	φ := radians(p.Lat)
+	south, north := φ-δ, φ+δ
+	if north >= math.Pi/2 || south <= -math.Pi/2 {
+		return Box{
+			South: degrees(math.Max(south, -math.Pi/2)), West: -180,
+			North: degrees(math.Min(north, math.Pi/2)), East: 180,
+		}
+	}
+	dλ := degrees(math.Asin(math.Sin(δ) / math.Cos(φ)))
+	return Box{
+		South: degrees(south), West: normalizeLong(p.Long - dλ),
+		North: degrees(north), East: normalizeLong(p.Long + dλ),
+	}
+}
+
+// CrossesAntimeridian reports whether b crosses the 180° meridian.
+func (b Box) CrossesAntimeridian() bool { return b.West > b.East }
+
+// Contains reports whether p is inside b or on its edge.
+func (b Box) Contains(p LatLng) bool {
+	if p.Lat < b.South || p.Lat > b.North {
+		return false
+	}
+	if b.West == -180 && b.East == 180 {
+		return true
+	}
+	long := normalizeLong(p.Long)
+	if b.CrossesAntimeridian() {
+		return long >= b.West || long <= b.East
+	}
+	return long >= b.West && long <= b.East
+}
+
+// Width returns the number of degrees of longitude b spans.
+func (b Box) Width() float64 {
+	if b.CrossesAntimeridian() {
+		return b.East + 360 - b.West
+	}
+	return b.East - b.West
+}
+
+// String returns the corners, "40.68433°N 122.08408°W – 37.42202°N 74.39967°W"
+// for the north-west one first.
+func (b Box) String() string {
+	return fmt.Sprintf("%v – %v", LatLng{b.North, b.West}, LatLng{b.South, b.East})
+}
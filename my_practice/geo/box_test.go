@@ -0,0 +1,63 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBound(t *testing.T) {
+	b, err := Bound(bell, google)
+	if want := (Box{37.42202, -122.08408, 40.68433, -74.39967}); err != nil || b != want {
+		t.Errorf("Bound(bell, google) = %v, %v; want %v", b, err, want)
+	}
+	if got, want := b.String(), "40.68433°N 122.08408°W – 37.42202°N 74.39967°W"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	if _, err := Bound(); err != ErrNoPoints {
+		t.Errorf("Bound(): err = %v, want %v", err, ErrNoPoints)
+	}
+}
+
+func TestBoxAntimeridian(t *testing.T) {
+	fiji := []LatLng{{-16.5, 179.5}, {-18.1, 178.4}, {-16.8, -179.9}, {-19.2, -178.7}}
+	b, err := Bound(fiji...)
+	if want := (Box{-19.2, 178.4, -16.5, -178.7}); err != nil || b != want {
+		t.Errorf("Bound(Fiji) = %v, %v; want %v", b, err, want)
+	}
+	if !b.CrossesAntimeridian() || math.Abs(b.Width()-2.9) > 1e-9 {
+		t.Errorf("Fiji: crosses 180° %v, width %v; want true, 2.9", b.CrossesAntimeridian(), b.Width())
+	}
+	for _, c := range []struct {
+		p    LatLng
+		want bool
+	}{
+		{LatLng{-17, 180}, true},
+		{LatLng{-17, 179}, true},
+		{LatLng{-17, 0}, false},
+		{LatLng{-10, 179}, false},
+	} {
+		if got := b.Contains(c.p); got != c.want {
+			t.Errorf("Fiji contains %v = %v, want %v", c.p, got, c.want)
+		}
+	}
+}
+
+func TestAround(t *testing.T) {
+	for _, c := range []struct {
+		p    LatLng
+		km   float64
+		want Box
+	}{
+		{bell, 100, Box{39.78501, -75.585655, 41.58365, -73.213685}},
+		{LatLng{0, 179.5}, 200, Box{-1.798641, 177.701359, 1.798641, -178.701359}},
+		// 극이 원 안에 들어가면 모든 경도를 덮는다
+		{LatLng{88, 10}, 300, Box{85.302039, -180, 90, 180}},
+		{LatLng{-60, 0}, 0, Box{-60, 0, -60, 0}},
+	} {
+		b := c.p.Around(c.km)
+		if !near(LatLng{b.South, b.West}, LatLng{c.want.South, c.want.West}, 5e-7) ||
+			!near(LatLng{b.North, b.East}, LatLng{c.want.North, c.want.East}, 5e-7) {
+			t.Errorf("around %v by %v km = %v, want %v", c.p, c.km, b, c.want)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Package geo computes with latitude and longitude, such as the Bell Labs
+// and Google positions that the tour stores in Vertex3_19: distances along
+// the Earth's surface, bearings, destination points, midpoints and
+// bounding boxes.
+//
+// Distance, Bearing, Destination, Midpoint and Around treat the Earth as a
+// sphere of radius EarthRadius, which is simple and within about 0.6% of
+// the truth. Vincenty uses the WGS 84 ellipsoid, as GPS does, and is
+// accurate to a millimetre. Distances are in kilometres and angles in
+// degrees.
+//
+// Index holds named positions, like the tour's map[string]Vertex3_19, and
+// finds the nearest ones, those within a distance or those in a Box.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometres.
+const EarthRadius = 6371.0088
+
+// WGS 84 타원체: 적도 반지름 a(m)와 편평률 f. 극 반지름은 b = (1-f)a.
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = (1 - wgs84F) * wgs84A
+)
+
+// ErrRange is wrapped by every RangeError.
+var ErrRange = errors.New("geo: coordinate out of range")
+
+// ErrNoConvergence is returned by Vincenty for points that are nearly
+// opposite each other on the globe, where the formula does not settle.
+var ErrNoConvergence = errors.New("geo: Vincenty formula did not converge")
+
+// RangeError reports a latitude outside [-90, 90] or a longitude outside
+// [-180, 180].
+type RangeError struct {
+	Field string // "latitude" or "longitude"
+	Value float64
+}
+
+func (e *RangeError) Error() string {
+	limit := 90
+	if e.Field == "longitude" {
+		limit = 180
+	}
+	return fmt.Sprintf("geo: %s %v out of range [-%d, %d]", e.Field, e.Value, limit, limit)
+}
+
+func (e *RangeError) Unwrap() error { return ErrRange }
+
+// LatLng is a position on the Earth in degrees: latitude north of the
+// equator, longitude east of Greenwich. It has the same fields as the
+// tour's Vertex3_19, so a Vertex3_19 v converts with LatLng(v); call
+// Validate on it, or build it with New, before computing with it.
+type LatLng struct {
+	Lat, Long float64
+}
+
+// New returns the position at lat, long. It returns a *RangeError if
+// either is out of range.
+func New(lat, long float64) (LatLng, error) {
+	p := LatLng{lat, long}
+	if err := p.Validate(); err != nil {
+		return LatLng{}, err
+	}
+	return p, nil
+}
+
+// Validate returns a *RangeError if p's latitude is outside [-90, 90] or
+// its longitude outside [-180, 180], and nil otherwise. NaN is out of
+// range.
+func (p LatLng) Validate() error {
+	// NaN은 어떤 비교도 거짓이므로 "범위 안"인지를 물어야 걸러진다
+	if !(p.Lat >= -90 && p.Lat <= 90) {
+		return &RangeError{"latitude", p.Lat}
+	}
+	if !(p.Long >= -180 && p.Long <= 180) {
+		return &RangeError{"longitude", p.Long}
+	}
+	return nil
+}
+
+// String returns p as "40.68433°N 74.39967°W".
+func (p LatLng) String() string {
+	ns, ew := "N", "E"
+	if p.Lat < 0 {
+		ns = "S"
+	}
+	if p.Long < 0 {
+		ew = "W"
+	}
+	return strconv.FormatFloat(math.Abs(p.Lat), 'f', -1, 64) + "°" + ns + " " +
+		strconv.FormatFloat(math.Abs(p.Long), 'f', -1, 64) + "°" + ew
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// normalizeLong returns long moved by a multiple of 360 into [-180, 180).
+func normalizeLong(long float64) float64 {
+	if long >= -180 && long < 180 {
+		return long // 이미 범위 안이면 Mod의 반올림 오차를 더하지 않는다
+	}
+	long = math.Mod(long+180, 360)
+	if long < 0 {
+		long += 360
+	}
+	return long - 180
+}
+
+// Distance returns the great-circle distance from p to q in kilometres,
+// by the haversine formula.
+func (p LatLng) Distance(q LatLng) float64 {
+	// hav(θ) = sin²(θ/2). 코사인 법칙과 달리 가까운 두 점에서도 반올림 오차가 작다.
+	φ1, φ2 := radians(p.Lat), radians(q.Lat)
+	dφ, dλ := φ2-φ1, radians(q.Long-p.Long)
+	h := math.Sin(dφ/2)*math.Sin(dφ/2) + math.Cos(φ1)*math.Cos(φ2)*math.Sin(dλ/2)*math.Sin(dλ/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// Vincenty returns the distance from p to q in kilometres along the WGS 84
+// ellipsoid, by Vincenty's inverse formula. It returns an error wrapping
+// ErrNoConvergence for nearly antipodal points.
+func (p LatLng) Vincenty(q LatLng) (float64, error) {
+	// 적도 쪽으로 부푼 타원체 위의 최단 거리. 보조구 위의 경도 차 λ를 반복해서 고친다.
+	L := radians(normalizeLong(q.Long - p.Long))
+	U1 := math.Atan((1 - wgs84F) * math.Tan(radians(p.Lat)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(radians(q.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	λ := L
+	var sinσ, cosσ, σ, cos2α, cos2σm float64
+	converged := false
+	for i := 0; i < 200; i++ {
+		sinλ, cosλ := math.Sincos(λ)
+		sinσ = math.Hypot(cosU2*sinλ, cosU1*sinU2-sinU1*cosU2*cosλ)
+		if sinσ == 0 {
+			return 0, nil // 같은 점
+		}
+		cosσ = sinU1*sinU2 + cosU1*cosU2*cosλ
+		σ = math.Atan2(sinσ, cosσ)
+		sinα := cosU1 * cosU2 * sinλ / sinσ
+		cos2α = 1 - sinα*sinα
+		cos2σm = 0 // 적도를 따라가는 경우
+		if cos2α != 0 {
+			cos2σm = cosσ - 2*sinU1*sinU2/cos2α
+		}
+		C := wgs84F / 16 * cos2α * (4 + wgs84F*(4-3*cos2α))
+		prev := λ
+		λ = L + (1-C)*wgs84F*sinα*(σ+C*sinσ*(cos2σm+C*cosσ*(-1+2*cos2σm*cos2σm)))
+		if math.Abs(λ) > math.Pi {
+			break // 거의 정반대인 두 점: 더 돌려도 수렴하지 않는다
+		}
+		if math.Abs(λ-prev) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return 0, fmt.Errorf("%w: from %v to %v", ErrNoConvergence, p, q)
+	}
+	u2 := cos2α * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	dσ := B * sinσ * (cos2σm + B/4*(cosσ*(-1+2*cos2σm*cos2σm)-
+		B/6*cos2σm*(-3+4*sinσ*sinσ)*(-3+4*cos2σm*cos2σm)))
+	return wgs84B * A * (σ - dσ) / 1000, nil
+}
+
+// Bearing returns the initial bearing from p to q in degrees clockwise from
+// north, in [0, 360): the direction to set off in. Along a great circle
+// the bearing changes on the way, except along a meridian or the equator.
+func (p LatLng) Bearing(q LatLng) float64 {
+	φ1, φ2 := radians(p.Lat), radians(q.Lat)
+	dλ := radians(q.Long - p.Long)
+	y := math.Sin(dλ) * math.Cos(φ2)
+	x := math.Cos(φ1)*math.Sin(φ2) - math.Sin(φ1)*math.Cos(φ2)*math.Cos(dλ)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination returns the point reached by travelling km kilometres from p
+// along a great circle, setting off at bearing degrees clockwise from
+// north.
+func (p LatLng) Destination(bearing, km float64) LatLng {
+	φ1, λ1 := radians(p.Lat), radians(p.Long)
+	θ, δ := radians(bearing), km/EarthRadius
+	sinφ2 := math.Sin(φ1)*math.Cos(δ) + math.Cos(φ1)*math.Sin(δ)*math.Cos(θ)
+	φ2 := math.Asin(math.Max(-1, math.Min(1, sinφ2)))
+	λ2 := λ1 + math.Atan2(math.Sin(θ)*math.Sin(δ)*math.Cos(φ1), math.Cos(δ)-math.Sin(φ1)*sinφ2)
+	return LatLng{degrees(φ2), normalizeLong(degrees(λ2))}
+}
+
+// Midpoint returns the point halfway from p to q along the great circle.
+func (p LatLng) Midpoint(q LatLng) LatLng {
+	// 두 점의 단위 벡터를 더한 방향이 중점이다
+	φ1, φ2 := radians(p.Lat), radians(q.Lat)
+	dλ := radians(q.Long - p.Long)
+	bx, by := math.Cos(φ2)*math.Cos(dλ), math.Cos(φ2)*math.Sin(dλ)
+	φ := math.Atan2(math.Sin(φ1)+math.Sin(φ2), math.Hypot(math.Cos(φ1)+bx, by))
+	λ := radians(p.Long) + math.Atan2(by, math.Cos(φ1)+bx)
+	return LatLng{degrees(φ), normalizeLong(degrees(λ))}
+}
@@ -0,0 +1,204 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var (
+	bell   = LatLng{40.68433, -74.39967}
+	google = LatLng{37.42202, -122.08408}
+)
+
+// near reports whether p and q are within eps degrees of each other.
+func near(p, q LatLng, eps float64) bool {
+	return math.Abs(p.Lat-q.Lat) <= eps && math.Abs(p.Long-q.Long) <= eps
+}
+
+func TestBellToGoogle(t *testing.T) {
+	if got := bell.String() + " → " + google.String(); got != "40.68433°N 74.39967°W → 37.42202°N 122.08408°W" {
+		t.Errorf("String = %q", got)
+	}
+	if d := bell.Distance(google); math.Abs(d-4082.982) > 5e-4 {
+		t.Errorf("haversine = %.4f km, want 4082.982", d)
+	}
+	if d, err := bell.Vincenty(google); err != nil || math.Abs(d-4092.851) > 5e-4 {
+		t.Errorf("Vincenty = %.4f km, %v; want 4092.851", d, err)
+	}
+	if b := bell.Bearing(google); math.Abs(b-280.8237) > 5e-5 {
+		t.Errorf("bearing = %.5f°, want 280.8237", b)
+	}
+	if b := google.Bearing(bell); math.Abs(b-69.6934) > 5e-5 {
+		t.Errorf("back bearing = %.5f°, want 69.6934", b)
+	}
+	if m, want := bell.Midpoint(google), (LatLng{41.572122, -98.826863}); !near(m, want, 5e-7) {
+		t.Errorf("midpoint = %v, want %v", m, want)
+	}
+}
+
+// 측지선 거리의 기준값:
+// Flinders Peak–Buninyong은 Vincenty(1975)의 예제, JFK–LHR은 GeographicLib GeodSolve 문서의 예제
+func TestReferenceGeodesics(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		p, q      LatLng
+		metres    float64
+		haversine float64 // 구 위의 거리 (m)
+		bearing   float64 // 구 위의 초기 방위각
+	}{
+		{"Flinders Peak–Buninyong",
+			LatLng{-(37 + 57/60.0 + 3.72030/3600), 144 + 25/60.0 + 29.52440/3600},
+			LatLng{-(37 + 39/60.0 + 10.15610/3600), 143 + 55/60.0 + 35.38390/3600},
+			54972.271, 54925.5, 306.984},
+		{"JFK–LHR", LatLng{40.6, -73.8}, LatLng{51.6, -0.5}, 5551759.400319, 5536892.0, 51.169},
+	} {
+		d, err := c.p.Vincenty(c.q)
+		if err != nil || math.Abs(d*1000-c.metres) >= 0.001 {
+			t.Errorf("%s: Vincenty %.4f m, %v; want %.3f m within 1 mm", c.name, d*1000, err, c.metres)
+		}
+		if h := c.p.Distance(c.q) * 1000; math.Abs(h-c.haversine) > 0.05 {
+			t.Errorf("%s: haversine %.2f m, want %.1f", c.name, h, c.haversine)
+		}
+		if b := c.p.Bearing(c.q); math.Abs(b-c.bearing) > 5e-4 {
+			t.Errorf("%s: bearing %.4f°, want %.3f", c.name, b, c.bearing)
+		}
+	}
+}
+
+// 널리 인용되는 도심 간 구면 거리. 도심 좌표를 어디로 잡느냐에 따라 1 km쯤 달라진다.
+func TestCityPairs(t *testing.T) {
+	cities := map[string]LatLng{
+		"London":      {51.5074, -0.1278},
+		"Paris":       {48.8566, 2.3522},
+		"New York":    {40.7128, -74.0060},
+		"Los Angeles": {34.0522, -118.2437},
+		"Sydney":      {-33.8688, 151.2093},
+		"Auckland":    {-36.8485, 174.7633},
+	}
+	for _, c := range []struct {
+		from, to       string
+		km             float64 // 인용된 거리
+		haversine, vin float64
+	}{
+		{"London", "Paris", 344, 343.6, 343.9},
+		{"New York", "Los Angeles", 3936, 3935.8, 3944.4},
+		{"Sydney", "Auckland", 2156, 2155.9, 2160.5},
+		{"London", "New York", 5570, 5570.2, 5585.2},
+	} {
+		p, q := cities[c.from], cities[c.to]
+		h := p.Distance(q)
+		v, err := p.Vincenty(q)
+		if math.Abs(h-c.haversine) > 0.05 || err != nil || math.Abs(v-c.vin) > 0.05 {
+			t.Errorf("%s → %s: haversine %.2f km, Vincenty %.2f km, %v; want %.1f, %.1f",
+				c.from, c.to, h, v, err, c.haversine, c.vin)
+		}
+		if math.Abs(h-c.km) >= c.km/100 {
+			t.Errorf("%s → %s: %.1f km, more than 1%% from the cited %v", c.from, c.to, h, c.km)
+		}
+	}
+}
+
+func TestDestination(t *testing.T) {
+	for _, c := range []struct {
+		p           LatLng
+		bearing, km float64
+		want        LatLng
+	}{
+		{bell, 0, 1000, LatLng{49.677534, -74.39967}},
+		{bell, 90, 1000, LatLng{40.081549, -62.611031}},
+		{bell, 180, 500, LatLng{36.187728, -74.39967}},
+		{bell, 270, 20015.1 / 2, LatLng{0.000042, -164.399621}},
+		{bell, 45, 0, bell},
+		// 극을 넘어가면 반대쪽 경선으로 내려온다
+		{LatLng{80, 0}, 0, 2 * math.Pi * EarthRadius * 20 / 360, LatLng{80, -180}},
+		{LatLng{0, 179}, 90, 2 * math.Pi * EarthRadius * 2 / 360, LatLng{0, -179}},
+	} {
+		d := c.p.Destination(c.bearing, c.km)
+		if !near(d, c.want, 5e-7) {
+			t.Errorf("%v, %v km at %v° = %v, want %v", c.p, c.km, c.bearing, d, c.want)
+		}
+		if back := c.p.Distance(d); math.Abs(back-c.km) > 1e-6 {
+			t.Errorf("%v, %v km at %v°: back %.9f km", c.p, c.km, c.bearing, back)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, c := range []struct {
+		lat, long float64
+		err       string // "" means valid
+	}{
+		{90, 180, ""},
+		{-90, -180, ""},
+		{90.0001, 0, "geo: latitude 90.0001 out of range [-90, 90]"},
+		{0, -180.5, "geo: longitude -180.5 out of range [-180, 180]"},
+		{math.NaN(), 0, "geo: latitude NaN out of range [-90, 90]"},
+		{0, math.Inf(1), "geo: longitude +Inf out of range [-180, 180]"},
+	} {
+		p, err := New(c.lat, c.long)
+		if c.err == "" {
+			if err != nil || p != (LatLng{c.lat, c.long}) {
+				t.Errorf("New(%v, %v) = %v, %v", c.lat, c.long, p, err)
+			}
+			continue
+		}
+		var re *RangeError
+		if err == nil || err.Error() != c.err || !errors.Is(err, ErrRange) || !errors.As(err, &re) {
+			t.Errorf("New(%v, %v): err = %v, want %s", c.lat, c.long, err, c.err)
+		}
+		if p != (LatLng{}) {
+			t.Errorf("New(%v, %v) = %v, want the zero LatLng", c.lat, c.long, p)
+		}
+	}
+}
+
+func TestVincentyErrors(t *testing.T) {
+	_, err := LatLng{0, 0}.Vincenty(LatLng{0.5, 179.7})
+	want := "geo: Vincenty formula did not converge: from 0°N 0°E to 0.5°N 179.7°E"
+	if err == nil || err.Error() != want || !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("nearly antipodal: err = %v, want %s", err, want)
+	}
+	if d, err := bell.Vincenty(bell); d != 0 || err != nil {
+		t.Errorf("same point: %v, %v", d, err)
+	}
+}
+
+// 난수 위치로 항등식을 확인한다.
+func TestGeoRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	rnd := func() LatLng {
+		// 구 위에 고르게: 위도는 sin이 고르게 퍼지도록 뽑는다
+		return LatLng{degrees(math.Asin(rng.Float64()*2 - 1)), rng.Float64()*360 - 180}
+	}
+	check := func(name string, p, q LatLng, ok bool) {
+		t.Helper()
+		if !ok {
+			t.Errorf("%s fails for %v, %v", name, p, q)
+		}
+	}
+	worst := 0.0
+	for i := 0; i < 2000; i++ {
+		p, q := rnd(), rnd()
+		dist := p.Distance(q)
+		check("distance is symmetric", p, q, math.Abs(dist-q.Distance(p)) < 1e-9)
+		check("destination(bearing, distance) = q", p, q, p.Destination(p.Bearing(q), dist).Distance(q) < 1e-6)
+		m := p.Midpoint(q)
+		check("midpoint is halfway", p, q, math.Abs(p.Distance(m)-dist/2) < 1e-6 && math.Abs(q.Distance(m)-dist/2) < 1e-6)
+		check("Around contains the circle", p, q, p.Around(dist+1e-6).Contains(q))
+		box, _ := Bound(p, q, m)
+		check("Bound contains its points", p, q, box.Contains(p) && box.Contains(q) && box.Contains(m))
+		check("results are in range", p, q, m.Validate() == nil && p.Destination(rng.Float64()*360, rng.Float64()*30000).Validate() == nil)
+		v, err := p.Vincenty(q)
+		if err != nil {
+			t.Errorf("Vincenty(%v, %v): %v", p, q, err)
+			continue
+		}
+		worst = math.Max(worst, math.Abs(dist-v)/v)
+	}
+	// 구와 타원체의 차이는 0.6%를 넘지 않는다
+	if worst > 0.006 {
+		t.Errorf("haversine vs Vincenty: worst %.2f%%, want at most 0.6%%", worst*100)
+	}
+}
@@ -0,0 +1,411 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// 공간 색인: k-d tree
+// 위도/경도를 그대로 x, y로 쓰면 180° 경선에서 끊기고 극 근처에서는 경도 1°가 거의 0 km라서
+// 거리 계산이 맞지 않는다. 그래서 각 점을 지구 중심에서 본 단위 벡터 (x, y, z)로 바꿔
+// 3차원 k-d tree에 넣는다. 두 단위 벡터 사이의 직선 거리(현의 길이)는 구면 거리가 클수록
+// 커지므로, 가장 가까운 점을 찾을 때는 현의 길이로 비교해도 결과가 같다.
+// 노드마다 아래 점들의 위도/경도 범위도 기억해 두어서 Box 검색에 쓴다.
+
+// Entry is a named position in an Index.
+type Entry struct {
+	Name string
+	Pos  LatLng
+}
+
+// Neighbor is an Entry found by a distance query, with its distance in
+// kilometres from the query point.
+type Neighbor struct {
+	Entry
+	Km float64
+}
+
+// Index is a set of named positions that answers nearest-neighbour,
+// radius and bounding-box queries without looking at every position, as
+// a map[string]LatLng would have to. Names are unique. The zero value is
+// not usable; call NewIndex or Build.
+type Index struct {
+	root  *node
+	names map[string]*node
+	dead  int
+}
+
+type node struct {
+	Entry
+	v           [3]float64 // Pos as a unit vector
+	axis        int        // the coordinate of v that splits left from right
+	left, right *node
+	size        int  // nodes in the subtree, deleted ones included
+	dead        bool // deleted, but still in the tree until the next rebuild
+	span        span // latitudes and longitudes in the subtree
+}
+
+// span is the range of latitudes and longitudes of the points in a
+// subtree. Unlike a Box it never crosses the 180° meridian: a subtree with
+// points on both sides spans nearly all longitudes, which is wasteful but
+// never wrong.
+type span struct {
+	south, north, west, east float64
+}
+
+func spanOf(p LatLng) span {
+	long := normalizeLong(p.Long)
+	return span{p.Lat, p.Lat, long, long}
+}
+
+func (s *span) union(o span) {
+	s.south, s.north = math.Min(s.south, o.south), math.Max(s.north, o.north)
+	s.west, s.east = math.Min(s.west, o.west), math.Max(s.east, o.east)
+}
+
+// overlaps reports whether any point in s could be in b.
+func (s span) overlaps(b Box) bool {
+	if s.north < b.South || s.south > b.North {
+		return false
+	}
+	if b.CrossesAntimeridian() {
+		return s.east >= b.West || s.west <= b.East
+	}
+	return s.east >= b.West && s.west <= b.East
+}
+
+// balance is how lopsided a subtree may get before Insert rebuilds it: no
+// child may hold more than this fraction of its parent's subtree.
+const balance = 0.7
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{names: make(map[string]*node)}
+}
+
+// Build returns an Index of points, balanced from the start, which is
+// faster than inserting them one by one. It returns an error wrapping a
+// *RangeError if any position is out of range.
+func Build(points map[string]LatLng) (*Index, error) {
+	ix := NewIndex()
+	nodes := make([]*node, 0, len(points))
+	for name, p := range points {
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("geo: %q: %w", name, err)
+		}
+		n := newNode(name, p)
+		nodes = append(nodes, n)
+		ix.names[name] = n
+	}
+	// map 순서는 매번 다르므로 이름순으로 정렬해서 늘 같은 트리를 만든다
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	ix.root = build(nodes)
+	return ix, nil
+}
+
+func newNode(name string, p LatLng) *node {
+	return &node{Entry: Entry{name, p}, v: unit(p), size: 1, span: spanOf(p)}
+}
+
+// unit returns p as a vector of length 1 from the centre of the Earth: x
+// towards 0°N 0°E, y towards 0°N 90°E and z towards the North Pole.
+func unit(p LatLng) [3]float64 {
+	φ, λ := radians(p.Lat), radians(p.Long)
+	return [3]float64{math.Cos(φ) * math.Cos(λ), math.Cos(φ) * math.Sin(λ), math.Sin(φ)}
+}
+
+// build returns a balanced tree of nodes, splitting each subtree at the
+// median of the coordinate in which its points are most spread out.
+func build(nodes []*node) *node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	axis, widest := 0, -1.0
+	for a := 0; a < 3; a++ {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, n := range nodes {
+			lo, hi = math.Min(lo, n.v[a]), math.Max(hi, n.v[a])
+		}
+		if hi-lo > widest {
+			axis, widest = a, hi-lo
+		}
+	}
+	m := len(nodes) / 2
+	partition(nodes, m, axis)
+	n := nodes[m]
+	n.axis = axis
+	n.left, n.right = build(nodes[:m]), build(nodes[m+1:])
+	n.size = len(nodes)
+	n.span = spanOf(n.Pos)
+	for _, c := range []*node{n.left, n.right} {
+		if c != nil {
+			n.span.union(c.span)
+		}
+	}
+	return n
+}
+
+// partition moves the node that sorting by v[axis] would put at index m
+// to m, with none greater before it and none smaller after it. It is
+// quickselect, which does not need to sort the rest.
+func partition(nodes []*node, m, axis int) {
+	lo, hi := 0, len(nodes)-1
+	for lo < hi {
+		pivot := nodes[lo+(hi-lo)/2].v[axis]
+		i, j := lo, hi
+		for i <= j {
+			for nodes[i].v[axis] < pivot {
+				i++
+			}
+			for nodes[j].v[axis] > pivot {
+				j--
+			}
+			if i <= j {
+				nodes[i], nodes[j] = nodes[j], nodes[i]
+				i, j = i+1, j-1
+			}
+		}
+		// 이제 [lo, j]는 pivot 이하, [i, hi]는 pivot 이상, 그 사이는 pivot과 같다
+		switch {
+		case m <= j:
+			hi = j
+		case m >= i:
+			lo = i
+		default:
+			return
+		}
+	}
+}
+
+// collect appends the nodes of the subtree at n to out, leaving out the
+// deleted ones if live is true.
+func collect(n *node, out []*node, live bool) []*node {
+	if n == nil {
+		return out
+	}
+	out = collect(n.left, out, live)
+	if !live || !n.dead {
+		out = append(out, n)
+	}
+	return collect(n.right, out, live)
+}
+
+// Len returns the number of positions in ix.
+func (ix *Index) Len() int { return len(ix.names) }
+
+// Get returns the position called name.
+func (ix *Index) Get(name string) (LatLng, bool) {
+	n, ok := ix.names[name]
+	if !ok {
+		return LatLng{}, false
+	}
+	return n.Pos, true
+}
+
+// Insert adds p under name, replacing the position already called name,
+// if any. It returns a *RangeError if p is out of range.
+func (ix *Index) Insert(name string, p LatLng) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	ix.Delete(name)
+	n := newNode(name, p)
+	ix.names[name] = n
+	if ix.root == nil {
+		ix.root = n
+		return nil
+	}
+
+	var path []*node
+	for cur := ix.root; ; {
+		path = append(path, cur)
+		cur.size++
+		cur.span.union(n.span)
+		next := &cur.right
+		if n.v[cur.axis] < cur.v[cur.axis] {
+			next = &cur.left
+		}
+		if *next == nil {
+			// 새 잎은 부모와 다른 좌표로 나눈다. 모두 같은 좌표로 나누면 다른 방향으로는 가지를 칠 수 없다.
+			n.axis = (cur.axis + 1) % 3
+			*next = n
+			break
+		}
+		cur = *next
+	}
+
+	// scapegoat tree의 방법: 너무 깊이 들어갔다면 경로 위에서 한쪽으로 치우친 subtree를 찾아
+	// 그것만 다시 균형 있게 만든다. 정렬된 순서로 넣어도 트리가 한 줄로 늘어지지 않는다.
+	if float64(len(path)) <= math.Log(float64(ix.root.size))/math.Log(1/balance) {
+		return nil
+	}
+	child := n
+	for i := len(path) - 1; i >= 0; i-- {
+		if float64(child.size) > balance*float64(path[i].size) {
+			rebuilt := build(collect(path[i], nil, false))
+			switch {
+			case i == 0:
+				ix.root = rebuilt
+			case path[i-1].left == path[i]:
+				path[i-1].left = rebuilt
+			default:
+				path[i-1].right = rebuilt
+			}
+			return nil
+		}
+		child = path[i]
+	}
+	return nil
+}
+
+// Delete removes the position called name, and reports whether there was
+// one.
+func (ix *Index) Delete(name string) bool {
+	n, ok := ix.names[name]
+	if !ok {
+		return false
+	}
+	// 노드를 바로 빼면 아래 subtree를 다시 맞춰야 하므로 표시만 해 두고,
+	// 지운 노드가 남은 노드보다 많아지면 한꺼번에 다시 만든다
+	n.dead = true
+	delete(ix.names, name)
+	ix.dead++
+	if ix.dead > len(ix.names) {
+		ix.root = build(collect(ix.root, nil, true))
+		ix.dead = 0
+	}
+	return true
+}
+
+// Nearest returns the k positions nearest to p, nearest first, or all of
+// them if there are fewer than k. Positions at the same distance come in
+// order of name.
+func (ix *Index) Nearest(p LatLng, k int) []Neighbor {
+	if k <= 0 {
+		return nil
+	}
+	q := unit(p)
+	// best는 지금까지 찾은 k개를 현의 길이 순으로 정렬해 둔 것
+	type candidate struct {
+		n  *node
+		d2 float64
+	}
+	var best []candidate
+	less := func(a, b candidate) bool {
+		if a.d2 != b.d2 {
+			return a.d2 < b.d2
+		}
+		return a.n.Name < b.n.Name
+	}
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		if !n.dead {
+			c := candidate{n, dist2(q, n.v)}
+			if len(best) < k || less(c, best[len(best)-1]) {
+				i := sort.Search(len(best), func(i int) bool { return less(c, best[i]) })
+				if len(best) < k {
+					best = append(best, candidate{})
+				}
+				copy(best[i+1:], best[i:])
+				best[i] = c
+			}
+		}
+		diff := q[n.axis] - n.v[n.axis]
+		near, far := n.right, n.left
+		if diff < 0 {
+			near, far = n.left, n.right
+		}
+		search(near)
+		// 가르는 평면까지의 거리가 지금 k번째보다 멀면 반대쪽에는 더 가까운 점이 없다
+		if len(best) < k || diff*diff <= best[len(best)-1].d2 {
+			search(far)
+		}
+	}
+	search(ix.root)
+
+	out := make([]Neighbor, len(best))
+	for i, c := range best {
+		out[i] = Neighbor{c.n.Entry, p.Distance(c.n.Pos)}
+	}
+	return out
+}
+
+// Within returns the positions at most km kilometres from p, nearest
+// first, by the same distance as LatLng.Distance.
+func (ix *Index) Within(p LatLng, km float64) []Neighbor {
+	if km < 0 {
+		return nil
+	}
+	q := unit(p)
+	// 구면 거리 δ(라디안)의 현의 길이는 2 sin(δ/2). 반올림 오차로 경계의 점을 놓치지 않게
+	// 조금 넉넉히 잡고, 마지막에는 Distance로 판단한다.
+	chord := 2 * math.Sin(math.Min(km/EarthRadius, math.Pi)/2)
+	limit := chord*chord*(1+1e-9) + 1e-15
+	var out []Neighbor
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		if !n.dead && dist2(q, n.v) <= limit {
+			if d := p.Distance(n.Pos); d <= km {
+				out = append(out, Neighbor{n.Entry, d})
+			}
+		}
+		diff := q[n.axis] - n.v[n.axis]
+		if diff < 0 || diff*diff <= limit {
+			search(n.left)
+		}
+		if diff >= 0 || diff*diff <= limit {
+			search(n.right)
+		}
+	}
+	search(ix.root)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Km != out[j].Km {
+			return out[i].Km < out[j].Km
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// InBox returns the positions inside b, in order of name.
+func (ix *Index) InBox(b Box) []Entry {
+	var out []Entry
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil || !n.span.overlaps(b) {
+			return
+		}
+		if !n.dead && b.Contains(n.Pos) {
+			out = append(out, n.Entry)
+		}
+		search(n.left)
+		search(n.right)
+	}
+	search(ix.root)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// depth returns the number of levels in the tree.
+func (ix *Index) depth() int {
+	var depth func(n *node) int
+	depth = func(n *node) int {
+		if n == nil {
+			return 0
+		}
+		return 1 + max(depth(n.left), depth(n.right))
+	}
+	return depth(ix.root)
+}
+
+func dist2(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
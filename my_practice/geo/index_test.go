@@ -0,0 +1,389 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func testCities() map[string]LatLng {
+	return map[string]LatLng{
+		"Bell Labs":     bell,
+		"Google":        google,
+		"New York":      {40.7128, -74.0060},
+		"Los Angeles":   {34.0522, -118.2437},
+		"San Francisco": {37.7749, -122.4194},
+		"London":        {51.5074, -0.1278},
+		"Paris":         {48.8566, 2.3522},
+		"Seoul":         {37.5665, 126.9780},
+		"Busan":         {35.1796, 129.0756},
+		"Tokyo":         {35.6762, 139.6503},
+		"Sydney":        {-33.8688, 151.2093},
+		"Auckland":      {-36.8485, 174.7633},
+		"Suva":          {-18.1248, 178.4501},
+		"Apia":          {-13.8333, -171.7667},
+		"Longyearbyen":  {78.2232, 15.6267},
+		"McMurdo":       {-77.8419, 166.6863},
+	}
+}
+
+// neighbors returns ns as "name km" with the distance rounded to a kilometre.
+func neighbors(ns []Neighbor) string {
+	var s []string
+	for _, n := range ns {
+		s = append(s, fmt.Sprintf("%s %.0f", n.Name, n.Km))
+	}
+	return strings.Join(s, ", ")
+}
+
+func entries(es []Entry) string {
+	var s []string
+	for _, e := range es {
+		s = append(s, e.Name)
+	}
+	return strings.Join(s, ", ")
+}
+
+func TestIndexQueries(t *testing.T) {
+	cities := testCities()
+	ix, err := Build(cities)
+	if err != nil || ix.Len() != 16 {
+		t.Fatalf("Build: len %d, %v", ix.Len(), err)
+	}
+	for _, c := range []struct {
+		name string
+		got  []Neighbor
+		want string
+	}{
+		{"3 nearest to Bell Labs", ix.Nearest(cities["Bell Labs"], 3), "Bell Labs 0, New York 33, Los Angeles 3903"},
+		{"2 nearest to Suva (across 180°)", ix.Nearest(cities["Suva"], 2), "Suva 0, Apia 1149"},
+		{"nearest to the North Pole", ix.Nearest(LatLng{90, 0}, 1), "Longyearbyen 1310"},
+		{"nearest to the South Pole", ix.Nearest(LatLng{-90, 123}, 1), "McMurdo 1352"},
+		{"within 1000 km of Seoul", ix.Within(cities["Seoul"], 1000), "Seoul 0, Busan 325"},
+		{"within 3000 km of Apia", ix.Within(cities["Apia"], 3000), "Apia 0, Suva 1149, Auckland 2888"},
+		{"within 0 km of Paris", ix.Within(cities["Paris"], 0), "Paris 0"},
+		{"within -1 km", ix.Within(cities["Paris"], -1), ""},
+		{"0 nearest", ix.Nearest(cities["Paris"], 0), ""},
+	} {
+		if got := neighbors(c.got); got != c.want {
+			t.Errorf("%s: %s, want %s", c.name, got, c.want)
+		}
+	}
+	if n := len(ix.Nearest(cities["London"], 100)); n != 16 {
+		t.Errorf("100 nearest to London: %d, want all 16", n)
+	}
+
+	seoulTokyo, _ := Bound(cities["Seoul"], cities["Tokyo"])
+	for _, c := range []struct {
+		box  Box
+		want string
+	}{
+		{Box{South: 24, West: -125, North: 50, East: -66}, "Bell Labs, Google, Los Angeles, New York, San Francisco"},
+		{Box{South: -40, West: 170, North: 0, East: -170}, "Apia, Auckland, Suva"},
+		{seoulTokyo, "Seoul, Tokyo"},
+	} {
+		if got := entries(ix.InBox(c.box)); got != c.want {
+			t.Errorf("in %v: %s, want %s", c.box, got, c.want)
+		}
+	}
+}
+
+func TestIndexInsertDelete(t *testing.T) {
+	cities := testCities()
+	ix, err := Build(cities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ix.Delete("Google") || ix.Delete("Google") || ix.Len() != 15 {
+		t.Errorf("delete Google twice: len %d", ix.Len())
+	}
+	if _, ok := ix.Get("Google"); ok {
+		t.Errorf("Get(Google) after Delete = true")
+	}
+	if got := neighbors(ix.Nearest(google, 1)); got != "San Francisco 49" {
+		t.Errorf("nearest to Google's position: %s", got)
+	}
+	if err := ix.Insert("Mountain View", LatLng{37.3861, -122.0839}); err != nil {
+		t.Fatal(err)
+	}
+	if got := neighbors(ix.Nearest(google, 1)); got != "Mountain View 4" {
+		t.Errorf("nearest to Google's position: %s", got)
+	}
+
+	// 같은 이름으로 다시 넣으면 옮겨진다
+	if err := ix.Insert("Seoul", cities["Busan"]); err != nil || ix.Len() != 16 {
+		t.Errorf("move Seoul: len %d, %v", ix.Len(), err)
+	}
+	if p, ok := ix.Get("Seoul"); !ok || p != cities["Busan"] {
+		t.Errorf("Seoul is at %v, %v; want %v", p, ok, cities["Busan"])
+	}
+	if got := neighbors(ix.Within(cities["Busan"], 1)); got != "Busan 0, Seoul 0" {
+		t.Errorf("within 1 km of Busan: %s", got)
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	ix := NewIndex()
+	for _, c := range []struct {
+		p    LatLng
+		want string
+	}{
+		{LatLng{91, 0}, "geo: latitude 91 out of range [-90, 90]"},
+		{LatLng{0, 181}, "geo: longitude 181 out of range [-180, 180]"},
+		{LatLng{math.NaN(), 0}, "geo: latitude NaN out of range [-90, 90]"},
+	} {
+		err := ix.Insert("bad", c.p)
+		var re *RangeError
+		if err == nil || err.Error() != c.want || !errors.As(err, &re) {
+			t.Errorf("Insert(%v): err = %v, want %s", c.p, err, c.want)
+		}
+	}
+	if ix.Len() != 0 {
+		t.Errorf("len %d after failed inserts", ix.Len())
+	}
+
+	_, err := Build(map[string]LatLng{"nowhere": {-95, 0}})
+	want := `geo: "nowhere": geo: latitude -95 out of range [-90, 90]`
+	if err == nil || err.Error() != want || !errors.Is(err, ErrRange) {
+		t.Errorf("Build: err = %v, want %s", err, want)
+	}
+}
+
+// 정렬된 순서로 넣어도 깊이가 log n 정도로 유지되는지
+func TestIndexBalance(t *testing.T) {
+	for _, n := range []int{100, 1000, 10000} {
+		pos := func(i int) LatLng { return LatLng{-80 + 160*float64(i)/float64(n), 0.01 * float64(i%7)} }
+		seq := NewIndex()
+		built := make(map[string]LatLng)
+		for i := 0; i < n; i++ {
+			seq.Insert(fmt.Sprint(i), pos(i))
+			built[fmt.Sprint(i)] = pos(i)
+		}
+		bix, err := Build(built)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Build는 완전히 균형 잡힌 나무를, Insert는 무게 균형 조건이 허락하는 만큼만 깊은 나무를 만든다
+		if want := int(math.Ceil(math.Log2(float64(n + 1)))); bix.depth() != want {
+			t.Errorf("%d points: built depth %d, want %d", n, bix.depth(), want)
+		}
+		if limit := math.Log(float64(n))/math.Log(1/balance) + 1; float64(seq.depth()) > limit {
+			t.Errorf("%d points: inserted in order depth %d, want at most %.1f", n, seq.depth(), limit)
+		}
+	}
+}
+
+// 같은 질의를 map 전체를 훑는 방법과 비교한다.
+func TestIndexRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	all := make(map[string]LatLng)
+	ix := NewIndex()
+	for i := 0; i < 3000; i++ {
+		name := fmt.Sprintf("p%d", rng.Intn(2000))
+		switch r := rng.Intn(10); {
+		case r < 6:
+			// 일부는 같은 곳에 겹쳐 놓는다
+			p := randomLatLng(rng)
+			if r == 0 && len(all) > 0 {
+				p, _ = ix.Get(fmt.Sprintf("p%d", rng.Intn(2000)))
+			}
+			all[name] = p
+			if err := ix.Insert(name, p); err != nil {
+				t.Fatal(err)
+			}
+		case r < 8:
+			_, had := all[name]
+			delete(all, name)
+			if got := ix.Delete(name); got != had {
+				t.Errorf("step %d: Delete(%s) = %v, want %v", i, name, got, had)
+			}
+		default:
+			q, k := randomLatLng(rng), 1+rng.Intn(8)
+			km := rng.ExpFloat64() * 1500
+			if got, want := neighbors(ix.Nearest(q, k)), neighbors(scanNearest(all, q, k)); got != want {
+				t.Errorf("step %d: %d nearest to %v: %s, want %s", i, k, q, got, want)
+			}
+			if got, want := neighbors(ix.Within(q, km)), neighbors(scanWithin(all, q, km)); got != want {
+				t.Errorf("step %d: within %.0f km of %v: %s, want %s", i, km, q, got, want)
+			}
+			box := q.Around(km)
+			if got, want := entries(ix.InBox(box)), entries(scanBox(all, box)); got != want {
+				t.Errorf("step %d: in %v: %s, want %s", i, box, got, want)
+			}
+		}
+		if ix.Len() != len(all) {
+			t.Fatalf("step %d: len %d, want %d", i, ix.Len(), len(all))
+		}
+	}
+}
+
+// randomLatLng returns a position spread evenly over the globe: the
+// latitude is drawn so that its sine is uniform.
+func randomLatLng(rng *rand.Rand) LatLng {
+	return LatLng{degrees(math.Asin(rng.Float64()*2 - 1)), rng.Float64()*360 - 180}
+}
+
+// scanNearest, scanWithin and scanBox answer the Index queries by looking
+// at every position, the way m3_21's map[string]Vertex3_19 has to.
+// Ties come in order of name, as they do from the Index.
+func scanNearest(points map[string]LatLng, p LatLng, k int) []Neighbor {
+	best := make([]Neighbor, 0, k+1)
+	for name, q := range points {
+		n := Neighbor{Entry{name, q}, p.Distance(q)}
+		i := sort.Search(len(best), func(i int) bool { return neighborLess(n, best[i]) })
+		if i == k {
+			continue
+		}
+		best = append(best, Neighbor{})
+		copy(best[i+1:], best[i:])
+		best[i] = n
+		if len(best) > k {
+			best = best[:k]
+		}
+	}
+	return best
+}
+
+func scanWithin(points map[string]LatLng, p LatLng, km float64) []Neighbor {
+	var out []Neighbor
+	for name, q := range points {
+		if d := p.Distance(q); d <= km {
+			out = append(out, Neighbor{Entry{name, q}, d})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return neighborLess(out[i], out[j]) })
+	return out
+}
+
+func scanBox(points map[string]LatLng, b Box) []Entry {
+	var out []Entry
+	for name, q := range points {
+		if b.Contains(q) {
+			out = append(out, Entry{name, q})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func neighborLess(a, b Neighbor) bool {
+	if a.Km != b.Km {
+		return a.Km < b.Km
+	}
+	return a.Name < b.Name
+}
+
+// 점이 많을수록 k-d tree와 전부 훑는 방법의 차이가 벌어진다.
+//
+//	go test ./geo -run - -bench .
+
+var benchSizes = []int{1000, 100000}
+
+// benchPoints returns n named positions spread evenly over the globe, and
+// positions to query from.
+func benchPoints(n int) (map[string]LatLng, []LatLng) {
+	rng := rand.New(rand.NewSource(1))
+	points := make(map[string]LatLng, n)
+	for i := 0; i < n; i++ {
+		points[fmt.Sprint("p", i)] = randomLatLng(rng)
+	}
+	queries := make([]LatLng, 256)
+	for i := range queries {
+		queries[i] = randomLatLng(rng)
+	}
+	return points, queries
+}
+
+// benchIndex builds an Index of benchPoints(n) by inserting them one at a
+// time, and resets the timer of b.
+func benchIndex(b *testing.B, n int) (*Index, []LatLng) {
+	points, queries := benchPoints(n)
+	ix := NewIndex()
+	for name, p := range points {
+		ix.Insert(name, p)
+	}
+	b.ResetTimer()
+	return ix, queries
+}
+
+func BenchmarkNearest(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("%d/scan", n), func(b *testing.B) {
+			points, queries := benchPoints(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				scanNearest(points, queries[i%len(queries)], 10)
+			}
+		})
+		b.Run(fmt.Sprintf("%d/index", n), func(b *testing.B) {
+			ix, queries := benchIndex(b, n)
+			for i := 0; i < b.N; i++ {
+				ix.Nearest(queries[i%len(queries)], 10)
+			}
+		})
+	}
+}
+
+func BenchmarkWithin(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("%d/scan", n), func(b *testing.B) {
+			points, queries := benchPoints(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				scanWithin(points, queries[i%len(queries)], 100)
+			}
+		})
+		b.Run(fmt.Sprintf("%d/index", n), func(b *testing.B) {
+			ix, queries := benchIndex(b, n)
+			for i := 0; i < b.N; i++ {
+				ix.Within(queries[i%len(queries)], 100)
+			}
+		})
+	}
+}
+
+func BenchmarkInBox(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("%d/scan", n), func(b *testing.B) {
+			points, queries := benchPoints(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				scanBox(points, queries[i%len(queries)].Around(500))
+			}
+		})
+		b.Run(fmt.Sprintf("%d/index", n), func(b *testing.B) {
+			ix, queries := benchIndex(b, n)
+			for i := 0; i < b.N; i++ {
+				ix.InBox(queries[i%len(queries)].Around(500))
+			}
+		})
+	}
+}
+
+func BenchmarkBuild(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			points, _ := benchPoints(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				Build(points)
+			}
+		})
+	}
+}
+
+func BenchmarkInsertDelete(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			ix, queries := benchIndex(b, n)
+			for i := 0; i < b.N; i++ {
+				ix.Insert("new", queries[i%len(queries)])
+				ix.Delete("new")
+			}
+		})
+	}
+}
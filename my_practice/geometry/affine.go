@@ -0,0 +1,188 @@
+package geometry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// 아핀 변환: 점 (x, y)를 (x, y, 1)로 보고 3×3 행렬을 곱한다.
+// 마지막 행은 늘 0 0 1이므로 위의 두 행, 숫자 여섯 개만 저장한다.
+// 세 번째 좌표 1 덕분에 평행이동도 곱셈이 되어서 여러 변환을 행렬 하나로 합칠 수 있다.
+
+// ErrSingular is returned when inverting a transform that squashes the
+// plane onto a line or a point, so that it cannot be undone.
+var ErrSingular = errors.New("geometry: singular matrix")
+
+// ErrEmptyStack is returned by Stack.Pop when nothing was pushed.
+var ErrEmptyStack = errors.New("geometry: pop from empty transform stack")
+
+// Affine is a 2D affine transform, the 3×3 matrix
+//
+//	| A  B  C |
+//	| D  E  F |
+//	| 0  0  1 |
+//
+// which moves (x, y) to (A x + B y + C, D x + E y + F). The zero value
+// squashes everything onto the origin; start from Identity instead.
+type Affine struct {
+	A, B, C float64
+	D, E, F float64
+}
+
+// Identity returns the transform that leaves every point where it is.
+func Identity() Affine { return Affine{A: 1, E: 1} }
+
+// Translate returns the transform that moves points by (dx, dy).
+func Translate(dx, dy float64) Affine { return Affine{A: 1, C: dx, E: 1, F: dy} }
+
+// Rotate returns the transform that rotates points counterclockwise by
+// theta radians around the origin.
+func Rotate(theta float64) Affine {
+	s, c := math.Sincos(theta)
+	return Affine{A: c, B: -s, D: s, E: c}
+}
+
+// RotateAround returns the transform that rotates points counterclockwise
+// by theta radians around p.
+func RotateAround(p Vec2[float64], theta float64) Affine {
+	return Translate(-p.X, -p.Y).Then(Rotate(theta)).Then(Translate(p.X, p.Y))
+}
+
+// Scale returns the transform that multiplies x by sx and y by sy. A
+// negative factor also mirrors.
+func Scale(sx, sy float64) Affine { return Affine{A: sx, E: sy} }
+
+// Shear returns the transform that adds kx times y to x and ky times x to
+// y, turning squares into parallelograms.
+func Shear(kx, ky float64) Affine { return Affine{A: 1, B: kx, D: ky, E: 1} }
+
+// Reflect returns the transform that mirrors points in the line through
+// the origin at theta radians from the X axis: Reflect(0) flips y and
+// Reflect(math.Pi/2) flips x.
+func Reflect(theta float64) Affine {
+	s, c := math.Sincos(2 * theta)
+	return Affine{A: c, B: s, D: s, E: -c}
+}
+
+// Mul returns the product m·n, which applies n first and then m.
+func (m Affine) Mul(n Affine) Affine {
+	return Affine{
+		A: m.A*n.A + m.B*n.D, B: m.A*n.B + m.B*n.E, C: m.A*n.C + m.B*n.F + m.C,
+		D: m.D*n.A + m.E*n.D, E: m.D*n.B + m.E*n.E, F: m.D*n.C + m.E*n.F + m.F,
+	}
+}
+
+// Then returns the transform that applies m and then n, that is n·m. A
+// chain of Then calls reads in the order the transforms happen.
+func (m Affine) Then(n Affine) Affine { return n.Mul(m) }
+
+// Det returns the determinant: the factor by which m multiplies areas,
+// negative if it also mirrors.
+func (m Affine) Det() float64 { return m.A*m.E - m.B*m.D }
+
+// Inverse returns the transform that undoes m. It returns an error
+// wrapping ErrSingular if the determinant is zero, or so small next to
+// the entries that the inverse would be mostly rounding error.
+func (m Affine) Inverse() (Affine, error) {
+	det := m.Det()
+	scale := math.Max(math.Max(math.Abs(m.A), math.Abs(m.B)), math.Max(math.Abs(m.D), math.Abs(m.E)))
+	if math.Abs(det) <= DefaultEpsilon*scale*scale || math.IsNaN(det) {
+		return Affine{}, fmt.Errorf("%w: determinant %g", ErrSingular, det)
+	}
+	// 선형 부분은 2×2 역행렬, 평행이동은 그 역행렬로 되돌린 만큼 반대로 옮긴다
+	inv := Affine{A: m.E / det, B: -m.B / det, D: -m.D / det, E: m.A / det}
+	inv.C = -(inv.A*m.C + inv.B*m.F)
+	inv.F = -(inv.D*m.C + inv.E*m.F)
+	return inv, nil
+}
+
+// ApplyXY returns the point (x, y) moved by m.
+func (m Affine) ApplyXY(x, y float64) (float64, float64) {
+	return m.A*x + m.B*y + m.C, m.D*x + m.E*y + m.F
+}
+
+// Apply returns p moved by m.
+func (m Affine) Apply(p Vec2[float64]) Vec2[float64] {
+	x, y := m.ApplyXY(p.X, p.Y)
+	return Vec2[float64]{x, y}
+}
+
+// ApplyAll moves every point of ps by m in place.
+func (m Affine) ApplyAll(ps []Vec2[float64]) {
+	for i := range ps {
+		ps[i] = m.Apply(ps[i])
+	}
+}
+
+// Equal reports whether every entry of m and n is within eps of each
+// other, relative to the entry when it is more than 1.
+func (m Affine) Equal(n Affine, eps float64) bool {
+	a := [6]float64{m.A, m.B, m.C, m.D, m.E, m.F}
+	b := [6]float64{n.A, n.B, n.C, n.D, n.E, n.F}
+	for i := range a {
+		if !EqualFloat(a[i], b[i], eps) {
+			return false
+		}
+	}
+	return true
+}
+
+// Approx is Equal with DefaultEpsilon.
+func (m Affine) Approx(n Affine) bool { return m.Equal(n, DefaultEpsilon) }
+
+// String returns the first two rows, "[a b c; d e f]".
+func (m Affine) String() string {
+	return fmt.Sprintf("[%v %v %v; %v %v %v]", m.A, m.B, m.C, m.D, m.E, m.F)
+}
+
+// Stack is a current transform with a stack of saved ones, like the
+// transform stack of a drawing API: Push saves the current transform, the
+// calls after it change it, and Pop brings back the saved one.
+type Stack struct {
+	top   Affine
+	saved []Affine
+}
+
+// NewStack returns a Stack whose current transform is Identity.
+func NewStack() *Stack { return &Stack{top: Identity()} }
+
+// Top returns the current transform.
+func (s *Stack) Top() Affine { return s.top }
+
+// Set replaces the current transform with m.
+func (s *Stack) Set(m Affine) { s.top = m }
+
+// Concat combines m with the current transform so that m is applied
+// first. This is how drawing APIs work: after Translate and then Rotate, a
+// shape is rotated around its own origin and then moved.
+func (s *Stack) Concat(m Affine) { s.top = s.top.Mul(m) }
+
+// Translate is Concat(Translate(dx, dy)).
+func (s *Stack) Translate(dx, dy float64) { s.Concat(Translate(dx, dy)) }
+
+// Rotate is Concat(Rotate(theta)).
+func (s *Stack) Rotate(theta float64) { s.Concat(Rotate(theta)) }
+
+// Scale is Concat(Scale(sx, sy)).
+func (s *Stack) Scale(sx, sy float64) { s.Concat(Scale(sx, sy)) }
+
+// Push saves the current transform.
+func (s *Stack) Push() { s.saved = append(s.saved, s.top) }
+
+// Pop makes the last saved transform current again. It returns
+// ErrEmptyStack, and leaves the current transform alone, if there is none.
+func (s *Stack) Pop() error {
+	if len(s.saved) == 0 {
+		return ErrEmptyStack
+	}
+	s.top = s.saved[len(s.saved)-1]
+	s.saved = s.saved[:len(s.saved)-1]
+	return nil
+}
+
+// Depth returns the number of saved transforms.
+func (s *Stack) Depth() int { return len(s.saved) }
+
+// Apply returns p moved by the current transform.
+func (s *Stack) Apply(p Vec2[float64]) Vec2[float64] { return s.top.Apply(p) }
@@ -0,0 +1,222 @@
+package geometry
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func must(m Affine, err error) Affine {
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestTransforms(t *testing.T) {
+	p := Vec2[float64]{2, 1}
+	for _, c := range []struct {
+		name string
+		m    Affine
+		want Affine
+		det  float64
+		p    Vec2[float64] // p를 옮긴 곳
+	}{
+		{"Identity", Identity(), Affine{1, 0, 0, 0, 1, 0}, 1, Vec2[float64]{2, 1}},
+		{"Translate(3, -1)", Translate(3, -1), Affine{1, 0, 3, 0, 1, -1}, 1, Vec2[float64]{5, 0}},
+		{"Rotate(90°)", Rotate(math.Pi / 2), Affine{0, -1, 0, 1, 0, 0}, 1, Vec2[float64]{-1, 2}},
+		{"RotateAround((1, 1), 90°)", RotateAround(Vec2[float64]{1, 1}, math.Pi/2), Affine{0, -1, 2, 1, 0, 0}, 1, Vec2[float64]{1, 2}},
+		{"Scale(2, -3)", Scale(2, -3), Affine{2, 0, 0, 0, -3, 0}, -6, Vec2[float64]{4, -3}},
+		{"Shear(1, 0)", Shear(1, 0), Affine{1, 1, 0, 0, 1, 0}, 1, Vec2[float64]{3, 1}},
+		{"Reflect(0), in the X axis", Reflect(0), Affine{1, 0, 0, 0, -1, 0}, -1, Vec2[float64]{2, -1}},
+		{"Reflect(45°), in y = x", Reflect(math.Pi / 4), Affine{0, 1, 0, 1, 0, 0}, -1, Vec2[float64]{1, 2}},
+	} {
+		if !c.m.Approx(c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.m, c.want)
+		}
+		if !EqualFloat(c.m.Det(), c.det, DefaultEpsilon) {
+			t.Errorf("%s: det %v, want %v", c.name, c.m.Det(), c.det)
+		}
+		if got := c.m.Apply(p); !got.Approx(c.p) {
+			t.Errorf("%s: %v → %v, want %v", c.name, p, got, c.p)
+		}
+	}
+	if got := Translate(3, -1).String(); got != "[1 0 3; 0 1 -1]" {
+		t.Errorf("String = %q", got)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	p := Vec2[float64]{2, 1}
+	tr, rot, sc := Translate(3, 0), Rotate(math.Pi/2), Scale(2, 1)
+	for _, c := range []struct {
+		name      string
+		got, want Vec2[float64]
+	}{
+		{"translate then rotate", tr.Then(rot).Apply(p), Vec2[float64]{-1, 5}},
+		{"rotate then translate", rot.Then(tr).Apply(p), Vec2[float64]{2, 2}},
+		{"step by step", rot.Apply(tr.Apply(p)), Vec2[float64]{-1, 5}},
+		{"scale x2 then rotate 90°", sc.Then(rot).Apply(p), Vec2[float64]{-1, 4}},
+		{"rotate 90° then scale x2", rot.Then(sc).Apply(p), Vec2[float64]{-2, 2}},
+	} {
+		if !c.got.Approx(c.want) {
+			t.Errorf("%s: %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if tr.Then(rot) != rot.Mul(tr) {
+		t.Errorf("Then is not Mul reversed: %v, %v", tr.Then(rot), rot.Mul(tr))
+	}
+	if got := Reflect(0.3).Then(Reflect(0.3)); !got.Approx(Identity()) {
+		t.Errorf("reflect twice = %v", got)
+	}
+	// 두 직선에 차례로 반사하면 사이 각의 두 배만큼 회전한다
+	if got := Reflect(0).Then(Reflect(math.Pi / 6)); !got.Approx(Rotate(math.Pi / 3)) {
+		t.Errorf("two reflections = %v, want %v", got, Rotate(math.Pi/3))
+	}
+}
+
+func TestInverse(t *testing.T) {
+	m := Translate(1, 2).Then(Rotate(0.5)).Then(Scale(3, -0.5)).Then(Shear(0.2, 0))
+	wantM := Affine{2.584805, -1.526035, -0.467265, -0.239713, -0.438791, -1.117295}
+	if !m.Equal(wantM, 1e-6) {
+		t.Errorf("m = %v, want %v", m, wantM)
+	}
+	inv, err := m.Inverse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantInv := Affine{0.292528, -1.017357, -1, -0.159809, -1.723203, -2}
+	if !inv.Equal(wantInv, 1e-6) {
+		t.Errorf("inverse = %v, want %v", inv, wantInv)
+	}
+	if !m.Then(inv).Approx(Identity()) {
+		t.Errorf("m then inverse = %v", m.Then(inv))
+	}
+	if !must(inv.Inverse()).Approx(m) {
+		t.Errorf("inverse of inverse = %v", must(inv.Inverse()))
+	}
+}
+
+func TestInverseSingular(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		m    Affine
+		err  string // "" means invertible
+	}{
+		{"Scale(0, 1)", Scale(0, 1), "geometry: singular matrix: determinant 0"},
+		{"zero value", Affine{}, "geometry: singular matrix: determinant 0"},
+		{"onto the line y = 2x", Affine{A: 1, B: 2, D: 2, E: 4, C: 5}, "geometry: singular matrix: determinant 0"},
+		// 작아도 모든 값이 고르게 작으면 되돌릴 수 있다
+		{"Scale(1e-6, 1e-6)", Scale(1e-6, 1e-6), ""},
+		{"Scale(1e6, 1e-12)", Scale(1e6, 1e-12), "geometry: singular matrix: determinant 1e-06"},
+	} {
+		_, err := c.m.Inverse()
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%s: %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != c.err || !errors.Is(err, ErrSingular) {
+			t.Errorf("%s: err = %v, want %s", c.name, err, c.err)
+		}
+	}
+}
+
+// 난수 행렬로 항등식을 확인한다.
+func TestAffineIdentities(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	rndAffine := func() Affine {
+		// 부호가 섞이도록 평행이동, 회전, 비율, 전단, 반사를 아무렇게나 합친다
+		m := Translate(rng.NormFloat64()*10, rng.NormFloat64()*10).
+			Then(Rotate(rng.Float64() * 2 * math.Pi)).
+			Then(Scale(0.1+rng.Float64()*5, 0.1+rng.Float64()*5)).
+			Then(Shear(rng.NormFloat64(), rng.NormFloat64()/4))
+		if rng.Intn(2) == 0 {
+			m = m.Then(Reflect(rng.Float64() * math.Pi))
+		}
+		return m
+	}
+	check := func(name string, i int, ok bool) {
+		t.Helper()
+		if !ok {
+			t.Errorf("%s fails for random matrices %d", name, i)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		a, b, c := rndAffine(), rndAffine(), rndAffine()
+		q := Vec2[float64]{rng.NormFloat64() * 10, rng.NormFloat64() * 10}
+		ia, err := a.Inverse()
+		if err != nil {
+			t.Errorf("random matrix %d: %v", i, err)
+			continue
+		}
+		check("a then a⁻¹ ≈ Identity", i, a.Then(ia).Approx(Identity()))
+		check("a⁻¹ then a ≈ Identity", i, ia.Then(a).Approx(Identity()))
+		check("a⁻¹(a(q)) ≈ q", i, ia.Apply(a.Apply(q)).Equal(q, 1e-8))
+		check("(a·b)(q) = a(b(q))", i, a.Mul(b).Apply(q).Approx(a.Apply(b.Apply(q))))
+		check("(a·b)·c ≈ a·(b·c)", i, a.Mul(b).Mul(c).Approx(a.Mul(b.Mul(c))))
+		check("det(a·b) = det a · det b", i, EqualFloat(a.Mul(b).Det(), a.Det()*b.Det(), 1e-9))
+		if ib, err := b.Inverse(); err == nil {
+			check("(a·b)⁻¹ ≈ b⁻¹·a⁻¹", i, must(a.Mul(b).Inverse()).Approx(ib.Mul(ia)))
+		}
+		check("rotation keeps distances", i, EqualFloat(Rotate(float64(i)).Apply(q).Abs(), q.Abs(), 1e-9))
+		// 넓이는 det배가 된다 (반사면 부호가 바뀐다)
+		tri := []Vec2[float64]{{0, 0}, {1, 0}, {0, 1}}
+		a.ApplyAll(tri)
+		check("area scales by det", i, EqualFloat(tri[1].Sub(tri[0]).Cross(tri[2].Sub(tri[0])), a.Det(), 1e-9))
+	}
+}
+
+func TestStack(t *testing.T) {
+	x := Vec2[float64]{1, 0}
+	s := NewStack()
+	s.Translate(10, 0)
+	s.Push()
+	s.Rotate(math.Pi / 2)
+	s.Push()
+	s.Scale(2, 2)
+	if got := s.Apply(x); !got.Approx(Vec2[float64]{10, 2}) || s.Depth() != 2 {
+		t.Errorf("translate, rotate, scale: %v → %v, depth %d; want (10, 2), depth 2", x, got, s.Depth())
+	}
+	for _, want := range []Vec2[float64]{{10, 1}, {11, 0}} {
+		if err := s.Pop(); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Apply(x); !got.Approx(want) {
+			t.Errorf("after pop, depth %d: %v → %v, want %v", s.Depth(), x, got, want)
+		}
+	}
+	// 빈 stack에서 Pop해도 지금 변환은 그대로다
+	if err := s.Pop(); err != ErrEmptyStack {
+		t.Errorf("pop from empty: err = %v, want %v", err, ErrEmptyStack)
+	}
+	if got := s.Apply(x); !got.Approx(Vec2[float64]{11, 0}) {
+		t.Errorf("after failed pop: %v → %v", x, got)
+	}
+
+	// 모양 하나를 여러 곳에 그릴 때처럼 쓴다
+	square := []Vec2[float64]{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	h := math.Sqrt2 / 2
+	want := [][]Vec2[float64]{
+		{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+		{{3, 0}, {3 + h, h}, {3, 2 * h}, {3 - h, h}},
+		{{6, 0}, {6, 1}, {5, 1}, {5, 0}},
+	}
+	s.Set(Identity())
+	for i := range want {
+		s.Push()
+		s.Translate(float64(3*i), 0)
+		s.Rotate(float64(i) * math.Pi / 4)
+		for j, c := range square {
+			if got := s.Apply(c); !got.Approx(want[i][j]) {
+				t.Errorf("square %d, corner %v → %v, want %v", i, c, got, want[i][j])
+			}
+		}
+		s.Pop()
+	}
+	if s.Top() != Identity() || s.Depth() != 0 {
+		t.Errorf("back to %v, depth %d", s.Top(), s.Depth())
+	}
+}
@@ -0,0 +1,352 @@
+package geometry
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Point is a point in the plane. It has the same fields as the tour's
+// Vertex, so a Vertex v converts with Point(v).
+type Point = Vec2[float64]
+
+// Measurer is implemented by shapes that have an area and a perimeter,
+// the way Abser is implemented by values that have a length.
+type Measurer interface {
+	Area() float64
+	Perimeter() float64
+}
+
+// 도형 계산은 모두 좌표를 그대로 비교한다(epsilon 없음).
+// 정수 좌표라면 정확하지만, 반올림 오차가 있는 점이 선 위에 있는지는 어느 쪽으로든 판단될 수 있다.
+
+// Polygon is a closed shape given by its corners in order. The last corner
+// joins back to the first, so it is not repeated. The edges should not
+// cross each other.
+type Polygon []Point
+
+// SignedArea returns the area of p by the shoelace formula: positive if the
+// corners go counterclockwise, negative if clockwise.
+func (p Polygon) SignedArea() float64 {
+	// 각 변과 원점이 이루는 삼각형의 부호 있는 넓이를 더한다.
+	// 다각형 밖의 부분은 방향이 반대인 삼각형끼리 지워진다.
+	var sum float64
+	for i := range p {
+		sum += p[i].Cross(p[(i+1)%len(p)])
+	}
+	return sum / 2
+}
+
+// Area returns the area of p.
+func (p Polygon) Area() float64 { return math.Abs(p.SignedArea()) }
+
+// Perimeter returns the total length of the edges of p.
+func (p Polygon) Perimeter() float64 {
+	if len(p) < 2 {
+		return 0
+	}
+	return Polyline(p).Length() + p[len(p)-1].Distance(p[0])
+}
+
+// Centroid returns the centre of mass of the area of p. If p has no area,
+// because it has fewer than three corners or they are all on a line, it
+// returns the average of the corners instead, or the origin if there are
+// none.
+func (p Polygon) Centroid() Point {
+	// 삼각형 (원점, p[i], p[i+1])의 무게중심을 부호 있는 넓이로 가중 평균한다
+	var cx, cy, a float64
+	for i := range p {
+		q, r := p[i], p[(i+1)%len(p)]
+		c := q.Cross(r)
+		cx += (q.X + r.X) * c
+		cy += (q.Y + r.Y) * c
+		a += c
+	}
+	if a == 0 {
+		if len(p) == 0 {
+			return Point{}
+		}
+		var sum Point
+		for _, q := range p {
+			sum = sum.Add(q)
+		}
+		return sum.Scale(1 / float64(len(p)))
+	}
+	return Point{cx / (3 * a), cy / (3 * a)}
+}
+
+// Contains reports whether q is inside p or on its boundary.
+func (p Polygon) Contains(q Point) bool {
+	// q에서 오른쪽으로 반직선을 그었을 때 변을 홀수 번 지나면 안쪽이다
+	in := false
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		if (Segment{a, b}).Contains(q) {
+			return true
+		}
+		// 꼭짓점을 두 번 세지 않도록 변의 아래쪽 끝은 넣고 위쪽 끝은 뺀다
+		if (a.Y > q.Y) != (b.Y > q.Y) {
+			x := a.X + (q.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if x > q.X {
+				in = !in
+			}
+		}
+	}
+	return in
+}
+
+// Edges returns the edges of p, the last one from the last corner back to
+// the first.
+func (p Polygon) Edges() []Segment {
+	if len(p) < 2 {
+		return nil
+	}
+	edges := make([]Segment, len(p))
+	for i := range p {
+		edges[i] = Segment{p[i], p[(i+1)%len(p)]}
+	}
+	return edges
+}
+
+// Simplify returns p with corners removed by the Ramer–Douglas–Peucker
+// algorithm, so that no removed corner is more than eps from the new
+// boundary. The result may have fewer than three corners if p is thin.
+func (p Polygon) Simplify(eps float64) Polygon {
+	if len(p) <= 3 {
+		return append(Polygon(nil), p...)
+	}
+	// 고리에는 끝점이 없으므로 p[0]과 가장 먼 꼭짓점에서 둘로 나눠 각각 줄인다
+	far, best := 0, -1.0
+	for i, q := range p {
+		if d := q.Distance(p[0]); d > best {
+			far, best = i, d
+		}
+	}
+	first := Polyline(p[:far+1]).Simplify(eps)
+	second := Polyline(append(append(Polyline(nil), p[far:]...), p[0])).Simplify(eps)
+	return append(Polygon(first), second[1:len(second)-1]...)
+}
+
+// Transform returns p with every corner moved by m.
+func (p Polygon) Transform(m Affine) Polygon {
+	out := append(Polygon(nil), p...)
+	m.ApplyAll(out)
+	return out
+}
+
+// String returns p in well-known text, "POLYGON ((0 0, 1 0, 0 1, 0 0))".
+// As WKT requires, the first corner is repeated at the end.
+func (p Polygon) String() string {
+	if len(p) == 0 {
+		return "POLYGON EMPTY"
+	}
+	return "POLYGON ((" + wktPoints(append(append([]Point(nil), p...), p[0])) + "))"
+}
+
+// ConvexHull returns the smallest convex polygon that contains all of
+// points, corners counterclockwise starting from the leftmost point (the
+// lowest of them if there are several), without corners in the middle of
+// an edge. If the points are all on a line it returns the two ends, or the
+// one point if they are all the same.
+func ConvexHull(points []Point) Polygon {
+	// Andrew's monotone chain: x, y 순으로 정렬해서 왼쪽에서 오른쪽으로 아래 껍질을,
+	// 오른쪽에서 왼쪽으로 위 껍질을 만든다. 왼쪽으로 꺾지 않는 점은 빼 버린다.
+	ps := append([]Point(nil), points...)
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].X != ps[j].X {
+			return ps[i].X < ps[j].X
+		}
+		return ps[i].Y < ps[j].Y
+	})
+	// 같은 점은 하나만 남긴다
+	n := 0
+	for i, q := range ps {
+		if i == 0 || q != ps[n-1] {
+			ps[n] = q
+			n++
+		}
+	}
+	ps = ps[:n]
+	if len(ps) < 3 {
+		return Polygon(ps)
+	}
+	hull := make(Polygon, 0, 2*len(ps))
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, q := range ps {
+			for len(hull) >= start+2 && hull[len(hull)-1].Sub(hull[len(hull)-2]).Cross(q.Sub(hull[len(hull)-1])) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, q)
+		}
+		// 각 껍질의 마지막 점은 다른 껍질의 첫 점이다
+		hull = hull[:len(hull)-1]
+		for i, j := 0, len(ps)-1; i < j; i, j = i+1, j-1 {
+			ps[i], ps[j] = ps[j], ps[i]
+		}
+	}
+	return hull
+}
+
+// Polyline is an open path through points in order.
+type Polyline []Point
+
+// Length returns the total length of the path.
+func (l Polyline) Length() float64 {
+	var sum float64
+	for i := 1; i < len(l); i++ {
+		sum += l[i-1].Distance(l[i])
+	}
+	return sum
+}
+
+// Simplify returns l with points removed by the Ramer–Douglas–Peucker
+// algorithm, so that no removed point is more than eps from the new path.
+// The first and last points are always kept.
+func (l Polyline) Simplify(eps float64) Polyline {
+	if len(l) <= 2 {
+		return append(Polyline(nil), l...)
+	}
+	// 양 끝을 잇는 선분에서 가장 먼 점이 eps보다 멀면 그 점은 남기고 양쪽을 다시 나눈다.
+	// 재귀 대신 남길 점에 표시를 하면서 구간을 스택에 쌓는다.
+	keep := make([]bool, len(l))
+	keep[0], keep[len(l)-1] = true, true
+	stack := [][2]int{{0, len(l) - 1}}
+	for len(stack) > 0 {
+		lo, hi := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+		seg := Segment{l[lo], l[hi]}
+		far, best := -1, eps
+		for i := lo + 1; i < hi; i++ {
+			if d := seg.Distance(l[i]); d > best {
+				far, best = i, d
+			}
+		}
+		if far >= 0 {
+			keep[far] = true
+			stack = append(stack, [2]int{lo, far}, [2]int{far, hi})
+		}
+	}
+	var out Polyline
+	for i, k := range keep {
+		if k {
+			out = append(out, l[i])
+		}
+	}
+	return out
+}
+
+// String returns l in well-known text, "LINESTRING (0 0, 1 1)".
+func (l Polyline) String() string {
+	if len(l) == 0 {
+		return "LINESTRING EMPTY"
+	}
+	return "LINESTRING (" + wktPoints(l) + ")"
+}
+
+// Segment is the straight line between two points.
+type Segment struct {
+	P, Q Point
+}
+
+// Length returns the length of s.
+func (s Segment) Length() float64 { return s.P.Distance(s.Q) }
+
+// Distance returns the distance from q to the nearest point of s.
+func (s Segment) Distance(q Point) float64 {
+	d := s.Q.Sub(s.P)
+	l2 := d.Dot(d)
+	if l2 == 0 {
+		return q.Distance(s.P)
+	}
+	// q를 s가 놓인 직선에 내린 수선의 발, 선분 밖이면 가까운 끝점
+	t := math.Max(0, math.Min(1, q.Sub(s.P).Dot(d)/l2))
+	return q.Distance(s.P.Add(d.Scale(t)))
+}
+
+// Contains reports whether q is on s.
+func (s Segment) Contains(q Point) bool {
+	return s.P.Sub(q).Cross(s.Q.Sub(q)) == 0 &&
+		math.Min(s.P.X, s.Q.X) <= q.X && q.X <= math.Max(s.P.X, s.Q.X) &&
+		math.Min(s.P.Y, s.Q.Y) <= q.Y && q.Y <= math.Max(s.P.Y, s.Q.Y)
+}
+
+// Intersect returns the part that s and o have in common and true, or
+// false if they do not meet. The common part is a segment with P == Q if
+// they cross or touch at one point, and a longer one if they lie on the
+// same line and overlap.
+func (s Segment) Intersect(o Segment) (Segment, bool) {
+	// s = P + t r, o = P' + u d 로 놓고 r×d로 t, u를 푼다
+	r, d := s.Q.Sub(s.P), o.Q.Sub(o.P)
+	pq := o.P.Sub(s.P)
+	denom := r.Cross(d)
+	if denom != 0 {
+		t, u := pq.Cross(d)/denom, pq.Cross(r)/denom
+		if t < 0 || t > 1 || u < 0 || u > 1 {
+			return Segment{}, false
+		}
+		// 끝점에서 만나면 반올림 오차가 없도록 계산한 점 대신 그 끝점을 돌려준다
+		x := s.P.Add(r.Scale(t))
+		for _, e := range []Point{s.P, s.Q, o.P, o.Q} {
+			if s.Contains(e) && o.Contains(e) {
+				x = e
+				break
+			}
+		}
+		return Segment{x, x}, true
+	}
+	if pq.Cross(r) != 0 || pq.Cross(d) != 0 {
+		return Segment{}, false // 평행하고 다른 직선 위에 있다
+	}
+	// 같은 직선 위: 끝점 중 양쪽 선분에 모두 있는 것들이 겹치는 구간의 끝이다
+	var ends []Point
+	for _, e := range []Point{s.P, s.Q, o.P, o.Q} {
+		if s.Contains(e) && o.Contains(e) {
+			ends = append(ends, e)
+		}
+	}
+	if len(ends) == 0 {
+		return Segment{}, false
+	}
+	// 겹치는 구간의 양 끝은 직선 방향으로 가장 작은 점과 가장 큰 점
+	dir := r
+	if dir == (Point{}) {
+		dir = d
+	}
+	lo, hi := ends[0], ends[0]
+	for _, e := range ends[1:] {
+		if e.Dot(dir) < lo.Dot(dir) {
+			lo = e
+		}
+		if e.Dot(dir) > hi.Dot(dir) {
+			hi = e
+		}
+	}
+	return Segment{lo, hi}, true
+}
+
+// String returns s in well-known text, "LINESTRING (0 0, 1 1)".
+func (s Segment) String() string { return Polyline{s.P, s.Q}.String() }
+
+func wktPoints(ps []Point) string {
+	var b strings.Builder
+	for i, q := range ps {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(wktNumber(q.X))
+		b.WriteByte(' ')
+		b.WriteString(wktNumber(q.Y))
+	}
+	return b.String()
+}
+
+// wktNumber formats x without an exponent, which WKT readers do not all
+// accept.
+func wktNumber(x float64) string {
+	if x == 0 {
+		x = 0 // -0
+	}
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
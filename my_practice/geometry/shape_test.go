@@ -0,0 +1,274 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var (
+	square = Polygon{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	lShape = Polygon{{0, 0}, {4, 0}, {4, 1}, {1, 1}, {1, 4}, {0, 4}}
+)
+
+func TestPolygon(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		p         Polygon
+		wkt       string
+		signed    float64
+		perimeter float64
+		centroid  Point
+	}{
+		{"square", square, "POLYGON ((0 0, 4 0, 4 4, 0 4, 0 0))", 16, 16, Point{2, 2}},
+		{"clockwise square", Polygon{{0, 0}, {0, 4}, {4, 4}, {4, 0}}, "POLYGON ((0 0, 0 4, 4 4, 4 0, 0 0))", -16, 16, Point{2, 2}},
+		{"3-4-5 triangle", Polygon{{0, 0}, {4, 0}, {0, 3}}, "POLYGON ((0 0, 4 0, 0 3, 0 0))", 6, 12, Point{4.0 / 3, 1}},
+		{"L shape", lShape, "POLYGON ((0 0, 4 0, 4 1, 1 1, 1 4, 0 4, 0 0))", 7, 16, Point{19.0 / 14, 19.0 / 14}},
+		// 넓이가 0이면 꼭짓점의 평균
+		{"flat", Polygon{{0, 0}, {1, 1}, {3, 3}}, "POLYGON ((0 0, 1 1, 3 3, 0 0))", 0, 6 * math.Sqrt2, Point{4.0 / 3, 4.0 / 3}},
+		{"one point", Polygon{{2, 5}}, "POLYGON ((2 5, 2 5))", 0, 0, Point{2, 5}},
+		{"empty", Polygon{}, "POLYGON EMPTY", 0, 0, Point{}},
+	} {
+		var m Measurer = c.p
+		if got := c.p.String(); got != c.wkt {
+			t.Errorf("%s: String = %q, want %q", c.name, got, c.wkt)
+		}
+		if c.p.SignedArea() != c.signed || m.Area() != math.Abs(c.signed) {
+			t.Errorf("%s: signed area %v, area %v, want %v", c.name, c.p.SignedArea(), m.Area(), c.signed)
+		}
+		if !EqualFloat(m.Perimeter(), c.perimeter, DefaultEpsilon) {
+			t.Errorf("%s: perimeter %v, want %v", c.name, m.Perimeter(), c.perimeter)
+		}
+		if got := c.p.Centroid(); !got.Approx(c.centroid) {
+			t.Errorf("%s: centroid %v, want %v", c.name, got, c.centroid)
+		}
+	}
+
+	got := Polygon{{0, 0}, {4, 0}, {0, 3}}.Edges()
+	want := []Segment{{Point{0, 0}, Point{4, 0}}, {Point{4, 0}, Point{0, 3}}, {Point{0, 3}, Point{0, 0}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Edges = %v, want %v", got, want)
+	}
+}
+
+// 경계 위의 점은 안에 있는 것으로 본다.
+func TestContains(t *testing.T) {
+	diamond := Polygon{{0, -2}, {2, 0}, {0, 2}, {-2, 0}}
+	for _, c := range []struct {
+		name string
+		p    Polygon
+		q    Point
+		want bool
+	}{
+		{"L", lShape, Point{0.5, 0.5}, true},
+		{"L", lShape, Point{2, 2}, false},
+		{"L", lShape, Point{3, 0.5}, true},
+		{"L", lShape, Point{0.5, 3}, true},
+		{"L", lShape, Point{1, 2}, true},
+		{"L", lShape, Point{4, 1}, true},
+		{"L", lShape, Point{0, 0}, true},
+		{"L", lShape, Point{-1, 1}, false},
+		{"L", lShape, Point{5, 1}, false},
+		{"L", lShape, Point{2, 1}, true},
+		{"L", lShape, Point{0.5, 1}, true},
+		// 반직선이 꼭짓점을 지나는 경우
+		{"diamond", diamond, Point{-1, 0}, true},
+		{"diamond", diamond, Point{-3, 0}, false},
+		{"diamond", diamond, Point{0, 0}, true},
+		{"diamond", diamond, Point{3, 0}, false},
+	} {
+		if got := c.p.Contains(c.q); got != c.want {
+			t.Errorf("%s contains %v = %v, want %v", c.name, c.q, got, c.want)
+		}
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	for _, c := range []struct {
+		ps   []Point
+		want string
+	}{
+		{[]Point{{0, 0}, {2, 0}, {1, 1}, {2, 2}, {0, 2}, {1, 0}, {2, 1}, {1, 2}, {0, 1}}, "POLYGON ((0 0, 2 0, 2 2, 0 2, 0 0))"},
+		{[]Point{{3, 3}, {1, 1}, {2, 2}, {0, 0}}, "POLYGON ((0 0, 3 3, 0 0))"},
+		{[]Point{{1, 1}, {1, 1}, {1, 1}}, "POLYGON ((1 1, 1 1))"},
+		{[]Point{{1, 1}, {1, 1}}, "POLYGON ((1 1, 1 1))"},
+		{[]Point{{5, -1}}, "POLYGON ((5 -1, 5 -1))"},
+		{nil, "POLYGON EMPTY"},
+	} {
+		if got := ConvexHull(c.ps).String(); got != c.want {
+			t.Errorf("ConvexHull(%v) = %s, want %s", c.ps, got, c.want)
+		}
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	seg := func(x1, y1, x2, y2 float64) Segment { return Segment{Point{x1, y1}, Point{x2, y2}} }
+	for _, c := range []struct {
+		name string
+		s, o Segment
+		want Segment
+		ok   bool
+	}{
+		{"crossing", seg(0, 0, 4, 4), seg(0, 4, 4, 0), seg(2, 2, 2, 2), true},
+		{"T at an end", seg(0, 0, 4, 0), seg(2, 0, 2, 3), seg(2, 0, 2, 0), true},
+		{"ends touch", seg(0, 0, 1, 1), seg(1, 1, 3, 0), seg(1, 1, 1, 1), true},
+		{"would cross if longer", seg(0, 0, 1, 1), seg(3, 0, 2, 1), Segment{}, false},
+		{"parallel", seg(0, 0, 4, 0), seg(0, 1, 4, 1), Segment{}, false},
+		{"collinear overlap", seg(0, 0, 4, 0), seg(6, 0, 2, 0), seg(2, 0, 4, 0), true},
+		{"collinear inside", seg(0, 0, 6, 6), seg(2, 2, 3, 3), seg(2, 2, 3, 3), true},
+		{"collinear touch", seg(0, 0, 2, 0), seg(2, 0, 5, 0), seg(2, 0, 2, 0), true},
+		{"collinear apart", seg(0, 0, 1, 0), seg(2, 0, 3, 0), Segment{}, false},
+		{"point on segment", seg(1, 1, 1, 1), seg(0, 0, 2, 2), seg(1, 1, 1, 1), true},
+		{"point off segment", seg(1, 2, 1, 2), seg(0, 0, 2, 2), Segment{}, false},
+		{"same point", seg(1, 2, 1, 2), seg(1, 2, 1, 2), seg(1, 2, 1, 2), true},
+	} {
+		got, ok := c.s.Intersect(c.o)
+		if got != c.want || ok != c.ok {
+			t.Errorf("%s: %v.Intersect(%v) = %v, %v, want %v, %v", c.name, c.s, c.o, got, ok, c.want, c.ok)
+		}
+		// 순서를 바꾸면 겹친 구간의 방향만 바뀔 수 있다
+		got, ok = c.o.Intersect(c.s)
+		if got != c.want && got != (Segment{c.want.Q, c.want.P}) || ok != c.ok {
+			t.Errorf("%s: %v.Intersect(%v) = %v, %v, want %v, %v", c.name, c.o, c.s, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+// 난수 점과 선분으로 ConvexHull, Intersect, Transform을 확인한다.
+func TestShapeRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	rnd := func(scale float64) Point { return Point{rng.Float64() * scale, rng.Float64() * scale} }
+	check := func(name string, i int, ok bool) {
+		t.Helper()
+		if !ok {
+			t.Errorf("%s fails for random input %d", name, i)
+		}
+	}
+	crossings := 0
+	for i := 0; i < 1000; i++ {
+		// 정수 좌표로 점이 여러 번 겹치거나 한 줄에 놓이는 경우도 나오게 한다
+		var ps []Point
+		for j := 0; j < 3+rng.Intn(30); j++ {
+			q := rnd(10)
+			ps = append(ps, Point{math.Floor(q.X), math.Floor(q.Y)})
+		}
+		hull := ConvexHull(ps)
+		all, convex := true, true
+		for _, q := range ps {
+			all = all && hull.Contains(q)
+		}
+		for j := range hull {
+			a, b, c := hull[j], hull[(j+1)%len(hull)], hull[(j+2)%len(hull)]
+			convex = convex && (len(hull) < 3 || b.Sub(a).Cross(c.Sub(b)) > 0)
+		}
+		check("hull contains every point", i, all)
+		check("hull turns left at every corner", i, convex)
+		check("hull is counterclockwise", i, len(hull) < 3 || hull.SignedArea() > 0)
+
+		s, o := Segment{rnd(10), rnd(10)}, Segment{rnd(10), rnd(10)}
+		x, ok := s.Intersect(o)
+		_, ok2 := o.Intersect(s)
+		check("intersect is symmetric", i, ok == ok2)
+		if ok {
+			crossings++
+			check("crossing is on both segments", i, s.Distance(x.P) < 1e-9 && o.Distance(x.P) < 1e-9)
+		} else {
+			// 만나지 않으면 한 선분의 끝점이 다른 선분의 양쪽에 있지 않다
+			side := func(a Segment, q Point) float64 { return a.Q.Sub(a.P).Cross(q.Sub(a.P)) }
+			check("no crossing when apart", i, side(s, o.P)*side(s, o.Q) > 0 || side(o, s.P)*side(o, s.Q) > 0)
+		}
+
+		m := Translate(rng.NormFloat64(), rng.NormFloat64()).Then(Rotate(rng.Float64())).Then(Scale(1+rng.Float64(), 0.5))
+		check("area of transform = |det| × area", i, EqualFloat(hull.Transform(m).Area(), math.Abs(m.Det())*hull.Area(), 1e-9))
+	}
+	if crossings != 225 {
+		t.Errorf("random segments that meet: %d of 1000, want 225", crossings)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	var wave Polyline
+	for i := 0; i <= 100; i++ {
+		x := float64(i) / 10
+		wave = append(wave, Point{x, math.Sin(x)})
+	}
+	for _, c := range []struct {
+		eps    float64
+		n      int
+		length float64
+	}{
+		{0, 101, 12.2511},
+		{0.001, 90, 12.2511},
+		{0.01, 36, 12.2443},
+		{0.1, 11, 12.1616},
+		{0.5, 5, 11.9280},
+		{2, 2, 10.0148},
+	} {
+		simple := wave.Simplify(c.eps)
+		if len(simple) != c.n || math.Abs(simple.Length()-c.length) > 5e-5 {
+			t.Errorf("sine eps %v: %d points, length %.4f; want %d, %.4f",
+				c.eps, len(simple), simple.Length(), c.n, c.length)
+		}
+		// 빠진 점은 모두 줄인 선에서 eps 안에 있어야 한다
+		for _, q := range wave {
+			d := math.Inf(1)
+			for j := 1; j < len(simple); j++ {
+				d = math.Min(d, Segment{simple[j-1], simple[j]}.Distance(q))
+			}
+			if d > c.eps {
+				t.Errorf("sine eps %v: %v is %v away from the simplified line", c.eps, q, d)
+			}
+		}
+	}
+
+	got := Polyline{{0, 0}, {1, 0.1}, {2, -0.1}, {3, 5}, {4, 6}, {5, 7}}.Simplify(0.5)
+	if want := (Polyline{{0, 0}, {2, -0.1}, {3, 5}, {5, 7}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Simplify(0.5) = %v, want %v", got, want)
+	}
+
+	var circle Polygon
+	for i := 0; i < 64; i++ {
+		s, c := math.Sincos(2 * math.Pi * float64(i) / 64)
+		circle = append(circle, Point{10 * c, 10 * s})
+	}
+	for _, c := range []struct {
+		eps  float64
+		n    int
+		area float64
+	}{
+		{0.1, 32, 312.145},
+		{1, 8, 200 * math.Sqrt2},
+		{5, 4, 200},
+	} {
+		simple := circle.Simplify(c.eps)
+		if len(simple) != c.n || math.Abs(simple.Area()-c.area) > 5e-4 {
+			t.Errorf("circle eps %v: %d corners, area %.3f; want %d, %.3f",
+				c.eps, len(simple), simple.Area(), c.n, c.area)
+		}
+	}
+
+	if got := square.Simplify(1); !reflect.DeepEqual(got, square) {
+		t.Errorf("square.Simplify(1) = %v", got)
+	}
+	got2 := Polygon{{0, 0}, {2, 0}, {4, 0}, {4, 4}, {2, 4.1}, {0, 4}}.Simplify(0.5)
+	if !reflect.DeepEqual(got2, square) {
+		t.Errorf("Simplify(0.5) = %v, want %v", got2, square)
+	}
+}
+
+func TestWKT(t *testing.T) {
+	for _, c := range []struct {
+		got  string
+		want string
+	}{
+		{Polyline{}.String(), "LINESTRING EMPTY"},
+		// -0은 0으로, 지수 표기 없이 쓴다
+		{Polyline{{1.5, math.Copysign(0, -1)}, {1e-7, 1e21}}.String(), "LINESTRING (1.5 0, 0.0000001 1000000000000000000000)"},
+		{Segment{Point{0, 0}, Point{1, 1}}.String(), "LINESTRING (0 0, 1 1)"},
+	} {
+		if c.got != c.want {
+			t.Errorf("got %q, want %q", c.got, c.want)
+		}
+	}
+}
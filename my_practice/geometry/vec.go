@@ -0,0 +1,220 @@
+// Package geometry does vector math on 2D and 3D points, the operations
+// that the tour's Vertex (float64 X, Y with Abs and Scale) and Vertex_1
+// (int X, Y) in utils do not have.
+//
+// Vec2 and Vec3 are generic over the element type, so Vec2[int] stands in
+// for Vertex_1 and Vec2[float64] for Vertex. Operations that stay exact on
+// integers (Add, Sub, Scale, Dot, Cross) keep the element type; the others
+// (Normalize, Lerp, Rotate) return float64 vectors. Abs returns the length,
+// so every vector satisfies the tour's Abser interface.
+//
+// Affine is a 3×3 matrix for transforms that Vertex.Scale cannot do:
+// translation, rotation, scaling by different factors along X and Y,
+// shear and reflection, combined in any order. Stack keeps a current
+// transform with push and pop, as drawing APIs do.
+//
+// Polygon, Polyline and Segment are shapes made of Points (Vec2[float64],
+// which a Vertex converts to): area, perimeter, point in polygon, convex
+// hull, segment intersection and simplification. They print as
+// well-known text (WKT).
+//
+// Floating-point results are rarely exactly equal, so compare them with
+// Equal and a tolerance, or Approx with DefaultEpsilon, rather than ==.
+package geometry
+
+import (
+	"fmt"
+	"math"
+)
+
+// Number is the element type of a vector.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// DefaultEpsilon is the tolerance of Approx.
+const DefaultEpsilon = 1e-9
+
+// EqualFloat reports whether a and b are within eps of each other, or
+// within eps times the larger of them when that is more than 1.
+func EqualFloat(a, b, eps float64) bool {
+	return within(math.Abs(a-b), math.Max(math.Abs(a), math.Abs(b)), eps)
+}
+
+// within reports whether a difference d between values of size up to
+// scale is at most eps, relative to scale when it is above 1.
+func within(d, scale, eps float64) bool {
+	return d <= eps*math.Max(1, scale)
+}
+
+// Vec2 is a 2D vector or point.
+type Vec2[T Number] struct {
+	X, Y T
+}
+
+// Add returns v + o.
+func (v Vec2[T]) Add(o Vec2[T]) Vec2[T] { return Vec2[T]{v.X + o.X, v.Y + o.Y} }
+
+// Sub returns v - o, the vector from o to v.
+func (v Vec2[T]) Sub(o Vec2[T]) Vec2[T] { return Vec2[T]{v.X - o.X, v.Y - o.Y} }
+
+// Scale returns v with both coordinates multiplied by f. Unlike
+// Vertex.Scale it does not change v.
+func (v Vec2[T]) Scale(f T) Vec2[T] { return Vec2[T]{v.X * f, v.Y * f} }
+
+// Neg returns -v.
+func (v Vec2[T]) Neg() Vec2[T] { return Vec2[T]{-v.X, -v.Y} }
+
+// Dot returns the dot product v·o.
+func (v Vec2[T]) Dot(o Vec2[T]) T { return v.X*o.X + v.Y*o.Y }
+
+// Cross returns the z coordinate of the 3D cross product of v and o: the
+// signed area of the parallelogram they span, positive when o is
+// counterclockwise from v.
+func (v Vec2[T]) Cross(o Vec2[T]) T { return v.X*o.Y - v.Y*o.X }
+
+// Abs returns the length of v, like Vertex.Abs.
+func (v Vec2[T]) Abs() float64 { return math.Hypot(float64(v.X), float64(v.Y)) }
+
+// Float returns v with float64 coordinates.
+func (v Vec2[T]) Float() Vec2[float64] { return Vec2[float64]{float64(v.X), float64(v.Y)} }
+
+// Normalize returns the vector of length 1 in the direction of v, or the
+// zero vector if v is zero.
+func (v Vec2[T]) Normalize() Vec2[float64] {
+	l := v.Abs()
+	if l == 0 {
+		return Vec2[float64]{}
+	}
+	return Vec2[float64]{float64(v.X) / l, float64(v.Y) / l}
+}
+
+// Lerp returns the point a fraction t of the way from v to o: v at t = 0,
+// o at t = 1, and beyond them outside [0, 1].
+func (v Vec2[T]) Lerp(o Vec2[T], t float64) Vec2[float64] {
+	a, b := v.Float(), o.Float()
+	return Vec2[float64]{a.X + (b.X-a.X)*t, a.Y + (b.Y-a.Y)*t}
+}
+
+// Distance returns the distance between the points v and o.
+func (v Vec2[T]) Distance(o Vec2[T]) float64 { return v.Float().Sub(o.Float()).Abs() }
+
+// Angle returns the angle from v to o in radians, in (-π, π], positive
+// counterclockwise. It is 0 if either is zero.
+func (v Vec2[T]) Angle(o Vec2[T]) float64 {
+	a, b := v.Float(), o.Float()
+	return math.Atan2(a.Cross(b), a.Dot(b))
+}
+
+// Heading returns the angle of v from the positive X axis in radians, in
+// (-π, π].
+func (v Vec2[T]) Heading() float64 { return math.Atan2(float64(v.Y), float64(v.X)) }
+
+// Rotate returns v rotated counterclockwise by theta radians around the
+// origin.
+func (v Vec2[T]) Rotate(theta float64) Vec2[float64] {
+	s, c := math.Sincos(theta)
+	x, y := float64(v.X), float64(v.Y)
+	return Vec2[float64]{x*c - y*s, x*s + y*c}
+}
+
+// Equal reports whether v and o are within eps of each other, or within
+// eps times their length when that is more than 1.
+func (v Vec2[T]) Equal(o Vec2[T], eps float64) bool {
+	return within(v.Distance(o), math.Max(v.Abs(), o.Abs()), eps)
+}
+
+// Approx is Equal with DefaultEpsilon.
+func (v Vec2[T]) Approx(o Vec2[T]) bool { return v.Equal(o, DefaultEpsilon) }
+
+// String returns "(x, y)".
+func (v Vec2[T]) String() string { return fmt.Sprintf("(%v, %v)", v.X, v.Y) }
+
+// Vec3 is a 3D vector or point.
+type Vec3[T Number] struct {
+	X, Y, Z T
+}
+
+// Add returns v + o.
+func (v Vec3[T]) Add(o Vec3[T]) Vec3[T] { return Vec3[T]{v.X + o.X, v.Y + o.Y, v.Z + o.Z} }
+
+// Sub returns v - o, the vector from o to v.
+func (v Vec3[T]) Sub(o Vec3[T]) Vec3[T] { return Vec3[T]{v.X - o.X, v.Y - o.Y, v.Z - o.Z} }
+
+// Scale returns v with every coordinate multiplied by f.
+func (v Vec3[T]) Scale(f T) Vec3[T] { return Vec3[T]{v.X * f, v.Y * f, v.Z * f} }
+
+// Neg returns -v.
+func (v Vec3[T]) Neg() Vec3[T] { return Vec3[T]{-v.X, -v.Y, -v.Z} }
+
+// Dot returns the dot product v·o.
+func (v Vec3[T]) Dot(o Vec3[T]) T { return v.X*o.X + v.Y*o.Y + v.Z*o.Z }
+
+// Cross returns the cross product v×o, perpendicular to both, with length
+// the area of the parallelogram they span.
+func (v Vec3[T]) Cross(o Vec3[T]) Vec3[T] {
+	return Vec3[T]{v.Y*o.Z - v.Z*o.Y, v.Z*o.X - v.X*o.Z, v.X*o.Y - v.Y*o.X}
+}
+
+// Abs returns the length of v.
+func (v Vec3[T]) Abs() float64 {
+	return math.Hypot(math.Hypot(float64(v.X), float64(v.Y)), float64(v.Z))
+}
+
+// Float returns v with float64 coordinates.
+func (v Vec3[T]) Float() Vec3[float64] {
+	return Vec3[float64]{float64(v.X), float64(v.Y), float64(v.Z)}
+}
+
+// Normalize returns the vector of length 1 in the direction of v, or the
+// zero vector if v is zero.
+func (v Vec3[T]) Normalize() Vec3[float64] {
+	l := v.Abs()
+	if l == 0 {
+		return Vec3[float64]{}
+	}
+	f := v.Float()
+	return Vec3[float64]{f.X / l, f.Y / l, f.Z / l}
+}
+
+// Lerp returns the point a fraction t of the way from v to o.
+func (v Vec3[T]) Lerp(o Vec3[T], t float64) Vec3[float64] {
+	a, b := v.Float(), o.Float()
+	return Vec3[float64]{a.X + (b.X-a.X)*t, a.Y + (b.Y-a.Y)*t, a.Z + (b.Z-a.Z)*t}
+}
+
+// Distance returns the distance between the points v and o.
+func (v Vec3[T]) Distance(o Vec3[T]) float64 { return v.Float().Sub(o.Float()).Abs() }
+
+// Angle returns the angle between v and o in radians, in [0, π]. It is 0
+// if either is zero.
+func (v Vec3[T]) Angle(o Vec3[T]) float64 {
+	// acos(dot)는 0과 π 근처에서 부정확하므로 atan2(|a×b|, a·b)를 쓴다
+	a, b := v.Float(), o.Float()
+	return math.Atan2(a.Cross(b).Abs(), a.Dot(b))
+}
+
+// Rotate returns v rotated by theta radians around axis, counterclockwise
+// when looking from the tip of axis towards the origin (the right-hand
+// rule). axis need not have length 1; a zero axis leaves v as it is.
+func (v Vec3[T]) Rotate(axis Vec3[T], theta float64) Vec3[float64] {
+	// Rodrigues' rotation formula
+	k, p := axis.Normalize(), v.Float()
+	if k == (Vec3[float64]{}) {
+		return p
+	}
+	s, c := math.Sincos(theta)
+	return p.Scale(c).Add(k.Cross(p).Scale(s)).Add(k.Scale(k.Dot(p) * (1 - c)))
+}
+
+// Equal reports whether v and o are within eps of each other, or within
+// eps times their length when that is more than 1.
+func (v Vec3[T]) Equal(o Vec3[T], eps float64) bool {
+	return within(v.Distance(o), math.Max(v.Abs(), o.Abs()), eps)
+}
+
+// Approx is Equal with DefaultEpsilon.
+func (v Vec3[T]) Approx(o Vec3[T]) bool { return v.Equal(o, DefaultEpsilon) }
+
+// String returns "(x, y, z)".
+func (v Vec3[T]) String() string { return fmt.Sprintf("(%v, %v, %v)", v.X, v.Y, v.Z) }
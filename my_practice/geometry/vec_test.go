@@ -0,0 +1,159 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// abser is the tour's Abser interface (utils.Abser), repeated here to show
+// that vectors satisfy it without importing utils.
+type abser interface {
+	Abs() float64
+}
+
+var (
+	_ abser = Vec2[float64]{}
+	_ abser = Vec3[int]{}
+)
+
+func TestVec2Int(t *testing.T) {
+	a, b := Vec2[int]{1, 2}, Vec2[int]{3, -4}
+	for _, c := range []struct {
+		name      string
+		got, want Vec2[int]
+	}{
+		{"a+b", a.Add(b), Vec2[int]{4, -2}},
+		{"a-b", a.Sub(b), Vec2[int]{-2, 6}},
+		{"-a", a.Neg(), Vec2[int]{-1, -2}},
+		{"3a", a.Scale(3), Vec2[int]{3, 6}},
+	} {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if a.Dot(b) != -5 || a.Cross(b) != -10 || b.Cross(a) != 10 {
+		t.Errorf("a·b = %d, a×b = %d, b×a = %d", a.Dot(b), a.Cross(b), b.Cross(a))
+	}
+	if b.Abs() != 5 || a.Distance(b) != math.Sqrt(40) {
+		t.Errorf("|b| = %v, distance %v", b.Abs(), a.Distance(b))
+	}
+	for _, c := range []struct {
+		name      string
+		got, want Vec2[float64]
+	}{
+		{"normalize b", b.Normalize(), Vec2[float64]{0.6, -0.8}},
+		{"lerp a→b 0.5", a.Lerp(b, 0.5), Vec2[float64]{2, -1}},
+		{"rotate a by 90°", a.Rotate(math.Pi / 2), Vec2[float64]{-2, 1}},
+	} {
+		if !c.got.Approx(c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if got := a.Angle(b) * 180 / math.Pi; !EqualFloat(got, -116.56505117707799, 1e-12) {
+		t.Errorf("angle a→b = %v°", got)
+	}
+	if got := a.String(); got != "(1, 2)" {
+		t.Errorf("String = %q", got)
+	}
+}
+
+func TestVec2Float(t *testing.T) {
+	v := Vec2[float64]{3, 4}
+	if v.Abs() != 5 || !EqualFloat(v.Heading(), math.Atan2(4, 3), 0) {
+		t.Errorf("|v| = %v, heading %v", v.Abs(), v.Heading())
+	}
+	// 한 바퀴 돌리면 ==로는 다르지만 Approx로는 같다
+	if r := v.Rotate(2 * math.Pi); r == v || !r.Approx(v) {
+		t.Errorf("rotate 360° = %v", r)
+	}
+	for _, c := range []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"0.1+0.2 ≈ 0.3", Vec2[float64]{0.1 + 0.2, 0}.Approx(Vec2[float64]{0.3, 0}), true},
+		{"1e12 apart by 1e-3", Vec2[float64]{1e12, 0}.Approx(Vec2[float64]{1e12 + 1e-3, 0}), true},
+		{"1 apart by 1e-6", Vec2[float64]{1, 0}.Approx(Vec2[float64]{1 + 1e-6, 0}), false},
+		{"Equal eps 1e-3", Vec2[float64]{1, 0}.Equal(Vec2[float64]{1 + 1e-6, 0}, 1e-3), true},
+	} {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if got := (Vec2[float64]{}).Normalize(); got != (Vec2[float64]{}) {
+		t.Errorf("normalize zero = %v", got)
+	}
+	if got := v.Angle(Vec2[float64]{}); got != 0 {
+		t.Errorf("angle with zero = %v", got)
+	}
+}
+
+func TestVec3(t *testing.T) {
+	x, y, z := Vec3[int]{1, 0, 0}, Vec3[int]{0, 1, 0}, Vec3[int]{0, 0, 1}
+	for _, c := range []struct {
+		name      string
+		got, want Vec3[int]
+	}{
+		{"x×y", x.Cross(y), z},
+		{"y×z", y.Cross(z), x},
+		{"z×x", z.Cross(x), y},
+		{"y×x", y.Cross(x), z.Neg()},
+	} {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	p := Vec3[float64]{1, 2, 2}
+	if p.Abs() != 3 || !p.Normalize().Approx(Vec3[float64]{1.0 / 3, 2.0 / 3, 2.0 / 3}) {
+		t.Errorf("|p| = %v, normalize %v", p.Abs(), p.Normalize())
+	}
+	if got := x.Rotate(z, math.Pi/2); !got.Approx(y.Float()) {
+		t.Errorf("x rotated 90° about z = %v, want %v", got, y)
+	}
+	if got := x.Angle(y); got != math.Pi/2 {
+		t.Errorf("angle x, y = %v", got)
+	}
+	if got := p.Rotate(Vec3[float64]{}, 1); got != p {
+		t.Errorf("rotate about zero axis = %v, want %v", got, p)
+	}
+}
+
+// 난수 벡터로 항등식을 확인한다.
+func TestVecIdentities(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	rnd2 := func() Vec2[float64] { return Vec2[float64]{rng.NormFloat64() * 10, rng.NormFloat64() * 10} }
+	rnd3 := func() Vec3[float64] {
+		return Vec3[float64]{rng.NormFloat64() * 10, rng.NormFloat64() * 10, rng.NormFloat64() * 10}
+	}
+	eq := func(a, b float64) bool { return EqualFloat(a, b, 1e-9) }
+	check := func(name string, i int, ok bool) {
+		t.Helper()
+		if !ok {
+			t.Errorf("%s fails for random vectors %d", name, i)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		a, b, c := rnd2(), rnd2(), rnd2()
+		s := rng.Float64()*4 - 2
+		check("2D: (a+b)-b = a", i, a.Add(b).Sub(b).Approx(a))
+		check("2D: a·b = |a||b|cos θ", i, eq(a.Dot(b), a.Abs()*b.Abs()*math.Cos(a.Angle(b))))
+		check("2D: a×b = |a||b|sin θ", i, eq(a.Cross(b), a.Abs()*b.Abs()*math.Sin(a.Angle(b))))
+		check("2D: a rotated by angle(a,b) ∥ b", i, a.Rotate(a.Angle(b)).Normalize().Approx(b.Normalize()))
+		check("2D: |rotate(a)| = |a|", i, eq(a.Rotate(s).Abs(), a.Abs()))
+		check("2D: |normalize(a)| = 1", i, eq(a.Normalize().Abs(), 1))
+		check("2D: lerp 0, 1", i, a.Lerp(b, 0).Approx(a) && a.Lerp(b, 1).Approx(b))
+		check("2D: |lerp(t) - a| = |t|·|b-a|", i, eq(a.Lerp(b, s).Distance(a), math.Abs(s)*b.Distance(a)))
+		check("2D: triangle inequality", i, a.Distance(c) <= a.Distance(b)+b.Distance(c)+1e-9)
+
+		p, q, r := rnd3(), rnd3(), rnd3()
+		check("3D: p×q ⟂ p, q", i, eq(p.Cross(q).Dot(p)+1, 1) && eq(p.Cross(q).Dot(q)+1, 1))
+		check("3D: |p×q| = |p||q|sin θ", i, eq(p.Cross(q).Abs(), p.Abs()*q.Abs()*math.Sin(p.Angle(q))))
+		check("3D: p·(q×r) = (p×q)·r", i, eq(p.Dot(q.Cross(r)), p.Cross(q).Dot(r)))
+		check("3D: q×p = -(p×q)", i, q.Cross(p).Approx(p.Cross(q).Neg()))
+		check("3D: |rotate(p)| = |p|", i, eq(p.Rotate(q, s).Abs(), p.Abs()))
+		check("3D: rotate keeps p·axis", i, eq(p.Rotate(q, s).Dot(q.Normalize()), p.Dot(q.Normalize())))
+		check("3D: rotate t then -t", i, p.Rotate(q, s).Rotate(q.Float(), -s).Approx(p))
+		check("3D: rotate by angle(p,q) about p×q", i, p.Rotate(p.Cross(q), p.Angle(q)).Normalize().Approx(q.Normalize()))
+	}
+}
@@ -0,0 +1,239 @@
+// Package hangul splits precomposed Hangul syllables into jamo and back,
+// extracts initial consonants (초성) for search, romanizes Hangul under the
+// Revised Romanization of Korean, and converts Hangul between NFC and NFD.
+//
+// Every function that takes a string has an io.Reader counterpart in
+// reader.go that does the same conversion on a stream.
+//
+// A precomposed syllable such as '한' (U+D55C) is computed from its jamo:
+//
+//	syllable = 0xAC00 + (initial*21 + medial)*28 + final
+//
+// where initial, medial and final are indexes into the 19 initial
+// consonants, 21 vowels and 27 final consonants (final 0 means none).
+package hangul
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	sBase = 0xAC00 // 가
+	lBase = 0x1100 // 초성 ᄀ
+	vBase = 0x1161 // 중성 ᅡ
+	tBase = 0x11A7 // 종성 ᆨ 바로 앞. 종성 index 0은 받침 없음
+
+	lCount = 19
+	vCount = 21
+	tCount = 28
+	nCount = vCount * tCount
+	sCount = lCount * nCount
+)
+
+// 호환용 자모(ㄱ, ㅏ 등 U+3130 block)는 자판으로 치는 글자라 검색어에 쓰인다.
+// 조합용 자모(U+1100 block)와는 순서가 달라서 표로 옮긴다.
+var (
+	compatInitials = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+	compatFinals   = []rune(" ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ") // 0번은 받침 없음
+)
+
+const (
+	compatVowelBase = 'ㅏ' // ㅏ..ㅣ are contiguous, in the same order as the medials
+	compatVowelLast = 'ㅣ'
+)
+
+// IsSyllable reports whether r is a precomposed Hangul syllable (가–힣).
+func IsSyllable(r rune) bool {
+	return r >= sBase && r < sBase+sCount
+}
+
+// Jamo is a syllable split into its initial consonant, medial vowel and
+// final consonant. Decompose returns conjoining jamo (U+1100 block);
+// Compat converts them to the compatibility jamo typed on a keyboard
+// (U+3130 block). Final is 0 for a syllable without a final consonant.
+type Jamo struct {
+	Initial, Medial, Final rune
+}
+
+// Decompose splits the syllable r into conjoining jamo. ok is false if r
+// is not a precomposed syllable.
+func Decompose(r rune) (j Jamo, ok bool) {
+	if !IsSyllable(r) {
+		return Jamo{}, false
+	}
+	l, v, t := split(r)
+	j = Jamo{lBase + rune(l), vBase + rune(v), 0}
+	if t != 0 {
+		j.Final = tBase + rune(t)
+	}
+	return j, true
+}
+
+// Compose is the inverse of Decompose. Each part may be a conjoining or a
+// compatibility jamo, so Compose(Jamo{'ㅎ', 'ㅏ', 'ㄴ'}) is '한'. ok is
+// false if a part is not a jamo that can stand in its position, such as
+// 'ㄸ' as a final.
+func Compose(j Jamo) (r rune, ok bool) {
+	l, ok1 := initialIndex(j.Initial)
+	v, ok2 := medialIndex(j.Medial)
+	t, ok3 := finalIndex(j.Final)
+	if !ok1 || !ok2 || !ok3 {
+		return 0, false
+	}
+	return compose(l, v, t), true
+}
+
+// Compat returns j with every part as a compatibility jamo. j is returned
+// unchanged if it does not describe a syllable.
+func (j Jamo) Compat() Jamo {
+	l, ok1 := initialIndex(j.Initial)
+	v, ok2 := medialIndex(j.Medial)
+	if _, ok3 := finalIndex(j.Final); !ok1 || !ok2 || !ok3 {
+		return j
+	}
+	c := Jamo{compatInitials[l], compatVowelBase + rune(v), 0}
+	if t, _ := finalIndex(j.Final); t != 0 {
+		c.Final = compatFinals[t]
+	}
+	return c
+}
+
+// String returns the parts of j one after another, leaving out a missing
+// final. Conjoining jamo render as one syllable; compatibility jamo as
+// separate letters ("ㅎㅏㄴ").
+func (j Jamo) String() string {
+	if j.Final == 0 {
+		return string([]rune{j.Initial, j.Medial})
+	}
+	return string([]rune{j.Initial, j.Medial, j.Final})
+}
+
+func split(r rune) (l, v, t int) {
+	s := int(r - sBase)
+	return s / nCount, s % nCount / tCount, s % tCount
+}
+
+func compose(l, v, t int) rune {
+	return sBase + rune((l*vCount+v)*tCount+t)
+}
+
+func initialIndex(r rune) (int, bool) {
+	if r >= lBase && r < lBase+lCount {
+		return int(r - lBase), true
+	}
+	for i, c := range compatInitials {
+		if c == r {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func medialIndex(r rune) (int, bool) {
+	switch {
+	case r >= vBase && r < vBase+vCount:
+		return int(r - vBase), true
+	case r >= compatVowelBase && r <= compatVowelLast:
+		return int(r - compatVowelBase), true
+	}
+	return 0, false
+}
+
+// finalIndex accepts 0 for no final consonant.
+func finalIndex(r rune) (int, bool) {
+	switch {
+	case r == 0:
+		return 0, true
+	case r > tBase && r < tBase+tCount:
+		return int(r - tBase), true
+	}
+	for i, c := range compatFinals[1:] {
+		if c == r {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// Choseong
+
+// 초성 검색: "ㅎㄱ"으로 "한글"을 찾는다.
+
+// Choseong replaces every syllable of s with its initial consonant as a
+// compatibility jamo, so "한글 검색" becomes "ㅎㄱ ㄱㅅ". A decomposed
+// syllable (NFD) gives the same result; other runes are kept.
+func Choseong(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if c, ok := choseong(r); ok {
+			if c != 0 {
+				b.WriteRune(c)
+			}
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// choseong maps r to its initial consonant. ok is false if r is not part
+// of a syllable; c is 0 for a conjoining medial or final, which belongs to
+// a syllable whose initial has already been written.
+func choseong(r rune) (c rune, ok bool) {
+	switch {
+	case IsSyllable(r):
+		l, _, _ := split(r)
+		return compatInitials[l], true
+	case r >= lBase && r < lBase+lCount:
+		return compatInitials[r-lBase], true
+	case r >= vBase && r < vBase+vCount, r > tBase && r < tBase+tCount:
+		return 0, true
+	}
+	return 0, false
+}
+
+// IndexChoseong returns the byte index in text of the first match of
+// query, or -1. Each compatibility consonant of query (ㄱ–ㅎ) matches any
+// syllable with that initial consonant and every other rune matches only
+// itself, so "ㅎ글" and "ㅎㄱ" both match "한글". text should be NFC.
+func IndexChoseong(text, query string) int {
+	q := []rune(query)
+	if len(q) == 0 {
+		return 0
+	}
+	for i := range text {
+		if matchChoseong(text[i:], q) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsChoseong reports whether IndexChoseong(text, query) >= 0.
+func ContainsChoseong(text, query string) bool {
+	return IndexChoseong(text, query) >= 0
+}
+
+func matchChoseong(text string, q []rune) bool {
+	for _, want := range q {
+		r, w := utf8.DecodeRuneInString(text)
+		if w == 0 {
+			return false
+		}
+		text = text[w:]
+		if r == want {
+			continue
+		}
+		if c, ok := choseong(r); !ok || c != want || !isCompatInitial(want) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCompatInitial(r rune) bool {
+	_, ok := initialIndex(r)
+	return ok && (r < lBase || r >= lBase+lCount)
+}
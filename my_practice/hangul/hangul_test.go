@@ -0,0 +1,247 @@
+package hangul
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"go-study/my_practice/readercheck"
+)
+
+func TestDecompose(t *testing.T) {
+	for _, c := range []struct {
+		r      rune
+		want   Jamo
+		compat string
+	}{
+		{'한', Jamo{0x1112, 0x1161, 0x11AB}, "ㅎㅏㄴ"},
+		{'글', Jamo{0x1100, 0x1173, 0x11AF}, "ㄱㅡㄹ"},
+		{'월', Jamo{0x110B, 0x116F, 0x11AF}, "ㅇㅝㄹ"},
+		{'드', Jamo{0x1103, 0x1173, 0}, "ㄷㅡ"},
+		{'가', Jamo{0x1100, 0x1161, 0}, "ㄱㅏ"},
+		{'힣', Jamo{0x1112, 0x1175, 0x11C2}, "ㅎㅣㅎ"},
+	} {
+		j, ok := Decompose(c.r)
+		if !ok || j != c.want {
+			t.Errorf("Decompose(%c) = %U %U %U, %v, want %U %U %U", c.r,
+				j.Initial, j.Medial, j.Final, ok, c.want.Initial, c.want.Medial, c.want.Final)
+		}
+		if got := j.Compat().String(); got != c.compat {
+			t.Errorf("Decompose(%c).Compat() = %q, want %q", c.r, got, c.compat)
+		}
+	}
+	if j, ok := Decompose('A'); ok || j != (Jamo{}) {
+		t.Errorf("Decompose(A) = %v, %v, want zero, false", j, ok)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	for _, c := range []struct {
+		j    Jamo
+		want rune
+	}{
+		{Jamo{'ㅎ', 'ㅏ', 'ㄴ'}, '한'},
+		{Jamo{'ᄒ', 'ㅏ', 0}, '하'}, // 조합용과 호환용을 섞어도 된다
+		{Jamo{'ㄱ', 'ㅏ', 'ㄸ'}, 0}, // ㄸ은 받침이 될 수 없다
+		{Jamo{'ㄳ', 'ㅏ', 0}, 0},   // ㄳ은 초성이 될 수 없다
+		{Jamo{'a', 'ㅏ', 0}, 0},
+	} {
+		r, ok := Compose(c.j)
+		if r != c.want || ok != (c.want != 0) {
+			t.Errorf("Compose(%q) = %q, %v, want %q", c.j.String(), r, ok, c.want)
+		}
+	}
+}
+
+// 11172개 음절을 모두 나눴다가 합쳐 본다.
+func TestAllSyllables(t *testing.T) {
+	var all strings.Builder
+	for r := rune(0xAC00); r <= 0xD7A3; r++ {
+		all.WriteRune(r)
+		if !IsSyllable(r) {
+			t.Errorf("IsSyllable(%c) = false", r)
+		}
+		j, ok := Decompose(r)
+		if !ok {
+			t.Errorf("Decompose(%c) failed", r)
+			continue
+		}
+		if a, ok := Compose(j); a != r || !ok {
+			t.Errorf("Compose(Decompose(%c)) = %q, %v", r, a, ok)
+		}
+		if b, ok := Compose(j.Compat()); b != r || !ok {
+			t.Errorf("Compose(Decompose(%c).Compat()) = %q, %v", r, b, ok)
+		}
+	}
+	s := all.String()
+	nfd := NFD(s)
+	if NFC(nfd) != s {
+		t.Error("NFC(NFD(all syllables)) differs")
+	}
+	if NFD(nfd) != nfd {
+		t.Error("NFD is not idempotent")
+	}
+	if Choseong(nfd) != Choseong(s) {
+		t.Error("Choseong differs for NFD input")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, c := range []struct {
+		in, nfc, nfd string
+	}{
+		{"\ud55c\uae00", "\ud55c\uae00", "\u1112\u1161\u11ab\u1100\u1173\u11af"},
+		{"\u1112\u1161\u11ab\u1100\u1173\u11af", "\ud55c\uae00", "\u1112\u1161\u11ab\u1100\u1173\u11af"},
+		// 중성 뒤에 끼어든 글자
+		{"\u1112\u1161 + \u11ab", "\ud558 + \u11ab", "\u1112\u1161 + \u11ab"},
+		// 받침 없는 음절 + 종성 → 각
+		{"\uac00\u11a8", "\uac01", "\u1100\u1161\u11a8"},
+		// 이미 받침이 있으면 그대로
+		{"\uac01\u11a8", "\uac01\u11a8", "\u1100\u1161\u11a8\u11a8"},
+		// 초성 두 개
+		{"\u1100\u1100\u1161", "\u1100\uac00", "\u1100\u1100\u1161"},
+		// 호환용 자모는 합치지 않는다
+		{"\u314e\u314f\u3134", "\u314e\u314f\u3134", "\u314e\u314f\u3134"},
+		{"Hello", "Hello", "Hello"},
+	} {
+		if got := NFC(c.in); got != c.nfc {
+			t.Errorf("NFC(%+q) = %+q, want %+q", c.in, got, c.nfc)
+		}
+		if got := NFD(c.in); got != c.nfd {
+			t.Errorf("NFD(%+q) = %+q, want %+q", c.in, got, c.nfd)
+		}
+	}
+}
+
+func TestChoseong(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"한글 검색", "ㅎㄱ ㄱㅅ"},
+		{NFD("한글"), "ㅎㄱ"},
+		{"Hello 월드!", "Hello ㅇㄷ!"},
+	} {
+		if got := Choseong(c.in); got != c.want {
+			t.Errorf("Choseong(%+q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+
+	const text = "한글 검색"
+	for _, c := range []struct {
+		query string
+		want  int
+	}{
+		{"ㅎㄱ", 0},
+		{"ㅎ글", 0},
+		{"ㄱㅅ", 7},
+		{"글 ㄱ", 3},
+		{"ㅎㄱㄱ", -1},
+		{"한", 0},
+		{"ㄲ", -1},
+		{"", 0},
+	} {
+		if got := IndexChoseong(text, c.query); got != c.want {
+			t.Errorf("IndexChoseong(%q, %q) = %d, want %d", text, c.query, got, c.want)
+		}
+		if got := ContainsChoseong(text, c.query); got != (c.want >= 0) {
+			t.Errorf("ContainsChoseong(%q, %q) = %v", text, c.query, got)
+		}
+	}
+}
+
+// 국어의 로마자 표기법 본문과 용례에서 가져온 것
+var romanizeTests = []struct{ hangul, want string }{
+	{"한국어", "hangugeo"},
+	{"서울", "seoul"},
+	{"부산", "busan"},
+	{"월드", "woldeu"},
+	{"신라", "silla"},
+	{"설날", "seollal"},
+	{"울릉", "ulleung"},
+	{"종로", "jongno"},
+	{"왕십리", "wangsimni"},
+	{"독립문", "dongnimmun"},
+	{"백마", "baengma"},
+	{"합니다", "hamnida"},
+	{"같이", "gachi"},
+	{"굳히다", "guchida"},
+	{"좋고", "joko"},
+	{"놓다", "nota"},
+	{"많다", "manta"},
+	{"좋아", "joa"},
+	{"앉아", "anja"},
+	{"읽어", "ilgeo"},
+	{"묵호", "mukho"},
+	{"집현전", "jiphyeonjeon"},
+	{"압구정", "apgujeong"},
+	{"여덟", "yeodeol"},
+	{"닭", "dak"},
+	{"의정부", "uijeongbu"},
+	{"구미", "gumi"},
+	{"옥천", "okcheon"},
+	{"합덕", "hapdeok"},
+	{"벚꽃", "beotkkot"},
+	{"별내", "byeollae"},
+	{"광희문", "gwanghuimun"},
+	{"Hello 월드! 안녕, 서울.", "Hello woldeu! annyeong, seoul."},
+}
+
+func TestRomanize(t *testing.T) {
+	for _, c := range romanizeTests {
+		if got := Romanize(c.hangul); got != c.want {
+			t.Errorf("Romanize(%s) = %s, want %s", c.hangul, got, c.want)
+		}
+	}
+	if got := Romanize(NFD("한국어 신라")); got != "hangugeo silla" {
+		t.Errorf("Romanize(NFD) = %s, want hangugeo silla", got)
+	}
+}
+
+// reader는 1 byte씩 읽거나 반씩 읽어도 string 함수와 같아야 한다.
+func TestReaders(t *testing.T) {
+	inputs := []string{
+		"Hello 월드! 한국어 신라 같이",
+		"한글 하 + \u11ab",
+		// 4KB씩 읽으므로 여러 번에 걸쳐 읽히고 경계에서 잘린다
+		strings.Repeat("독립문 종로 왕십리 ", 500) + strings.Repeat("한", 3000),
+	}
+	readers := []struct {
+		name string
+		f    func(string) string
+		wrap func(io.Reader) io.Reader
+	}{
+		{"NFD", NFD, NFDReader},
+		{"NFC", NFC, NFCReader},
+		{"Choseong", Choseong, ChoseongReader},
+		{"Romanize", Romanize, RomanizeReader},
+	}
+	sources := []struct {
+		name string
+		wrap func(io.Reader) io.Reader
+	}{
+		{"plain", func(r io.Reader) io.Reader { return r }},
+		{"OneByteReader", iotest.OneByteReader},
+		{"HalfReader", iotest.HalfReader},
+		{"DataErrReader", iotest.DataErrReader},
+	}
+	for _, rd := range readers {
+		for i, in := range inputs {
+			want := rd.f(in)
+			for _, src := range sources {
+				b, err := io.ReadAll(rd.wrap(src.wrap(strings.NewReader(in))))
+				if err != nil || string(b) != want {
+					t.Errorf("%sReader input %d via %s = %+.40q..., %v, want %+.40q...", rd.name, i, src.name, b, err, want)
+				}
+			}
+		}
+		if err := readercheck.Validate(rd.wrap(iotest.HalfReader(strings.NewReader(inputs[2])))); err != nil {
+			t.Errorf("%sReader: %v", rd.name, err)
+		}
+	}
+
+	fire := errors.New("disk on fire")
+	b, err := io.ReadAll(RomanizeReader(io.MultiReader(strings.NewReader("한국"), iotest.ErrReader(fire))))
+	if string(b) != "hanguk" || err != fire {
+		t.Errorf("read error: %q, %v, want %q, %v", b, err, "hanguk", fire)
+	}
+}
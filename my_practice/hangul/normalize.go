@@ -0,0 +1,65 @@
+package hangul
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NFC and NFD
+
+// macOS 파일 이름처럼 NFD로 저장된 한글은 "한"이 ᄒ+ᅡ+ᆫ 세 rune이라
+// NFC인 "한"과 ==로 비교하면 다르다. 둘 중 하나로 맞춰야 비교나 검색이 된다.
+// 여기서는 한글 음절과 조합용 자모만 다룬다. 다른 글자의 결합 문자는 그대로 둔다.
+
+// NFD decomposes every precomposed syllable of s into conjoining jamo,
+// as Unicode canonical decomposition does. Other runes are unchanged.
+func NFD(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+	for _, r := range s {
+		if j, ok := Decompose(r); ok {
+			b.WriteString(j.String())
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// NFC composes every sequence of conjoining jamo in s that forms a modern
+// syllable (initial + medial, optionally + final) into the precomposed
+// syllable, as Unicode canonical composition does for Hangul. A final
+// following a syllable without one is also absorbed, so NFC(NFD(s)) == s
+// for any s whose Hangul is already composed. Other runes are unchanged.
+func NFC(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		i += w
+		next, nw := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case r >= lBase && r < lBase+lCount && next >= vBase && next < vBase+vCount:
+			// 초성 + 중성
+			r = compose(int(r-lBase), int(next-vBase), 0)
+			i += nw
+			next, nw = utf8.DecodeRuneInString(s[i:])
+			fallthrough
+		case IsSyllable(r) && (r-sBase)%tCount == 0:
+			// 받침 없는 음절 + 종성
+			if next > tBase && next < tBase+tCount {
+				r += next - tBase
+				i += nw
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// nfcBoundary reports whether NFC can cut a string before r without
+// changing the result: r never joins the rune before it.
+func nfcBoundary(prev, r rune) bool {
+	return !(r >= vBase && r < vBase+vCount) && !(r > tBase && r < tBase+tCount)
+}
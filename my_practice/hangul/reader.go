@@ -0,0 +1,118 @@
+package hangul
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// Streaming readers
+
+// transform 패키지의 reader는 byte 하나씩 바꾸면 되지만, 여기서는 rune 단위이고
+// NFC와 Romanize는 앞뒤 글자를 같이 봐야 한다. 그래서 읽은 것을 모아 두었다가
+// 결과가 바뀌지 않는 경계(boundary)까지만 변환해서 내보내고, 나머지는 다음 Read로 넘긴다.
+
+// maxPending bounds how much input a reader holds back looking for a
+// boundary. A longer run of Hangul without a space is cut there, so a
+// sound change across that cut may be missed.
+const maxPending = 64 * 1024
+
+type reader struct {
+	r        io.Reader
+	f        func(string) string
+	boundary func(prev, r rune) bool // r 앞에서 잘라도 되는가
+	buf      []byte                  // 읽었지만 아직 변환하지 않은 입력
+	out      []byte                  // 변환했지만 아직 돌려주지 않은 출력
+	err      error
+}
+
+func newReader(r io.Reader, f func(string) string, boundary func(prev, r rune) bool) io.Reader {
+	return &reader{r: r, f: f, boundary: boundary}
+}
+
+func (t *reader) Read(b []byte) (int, error) {
+	for len(t.out) == 0 {
+		if t.err != nil {
+			if len(t.buf) > 0 {
+				// 끝까지 읽었으니 남은 것을 모두 변환한다
+				t.out = []byte(t.f(string(t.buf)))
+				t.buf = nil
+				continue
+			}
+			return 0, t.err
+		}
+		if len(b) == 0 {
+			return 0, nil
+		}
+		t.fill()
+	}
+	n := copy(b, t.out)
+	t.out = t.out[n:]
+	return n, nil
+}
+
+// fill reads once from the underlying reader and converts the input up
+// to its last boundary.
+func (t *reader) fill() {
+	var chunk [4096]byte
+	n, err := t.r.Read(chunk[:])
+	t.buf = append(t.buf, chunk[:n]...)
+	if err != nil {
+		t.err = err
+		return
+	}
+	if cut := t.lastBoundary(); cut > 0 {
+		t.out = []byte(t.f(string(t.buf[:cut])))
+		t.buf = append(t.buf[:0], t.buf[cut:]...)
+	}
+}
+
+// lastBoundary returns the byte offset of the last place buf can be cut,
+// or 0. The last rune is never cut before, since the one after it is not
+// known yet; a rune split across reads is never cut either.
+func (t *reader) lastBoundary() int {
+	end := len(t.buf)
+	// 끝에 잘린 UTF-8이 있으면 그 앞까지만 본다
+	for i := 1; i < utf8.UTFMax && i <= end; i++ {
+		if utf8.RuneStart(t.buf[end-i]) {
+			if !utf8.FullRune(t.buf[end-i:]) {
+				end -= i
+			}
+			break
+		}
+	}
+	r, w := utf8.DecodeLastRune(t.buf[:end])
+	for i := end - w; i > 0; i -= w {
+		var prev rune
+		prev, w = utf8.DecodeLastRune(t.buf[:i])
+		if t.boundary(prev, r) || len(t.buf) > maxPending {
+			return i
+		}
+		r = prev
+	}
+	return 0
+}
+
+func anyBoundary(prev, r rune) bool { return true }
+
+// NFDReader returns a reader that yields NFD(s) for the text s of r.
+func NFDReader(r io.Reader) io.Reader {
+	return newReader(r, NFD, anyBoundary)
+}
+
+// NFCReader returns a reader that yields NFC(s) for the text s of r.
+func NFCReader(r io.Reader) io.Reader {
+	return newReader(r, NFC, nfcBoundary)
+}
+
+// ChoseongReader returns a reader that yields Choseong(s) for the text s
+// of r.
+func ChoseongReader(r io.Reader) io.Reader {
+	return newReader(r, Choseong, anyBoundary)
+}
+
+// RomanizeReader returns a reader that yields Romanize(s) for the text s
+// of r. A run of more than 64KB of Hangul with no other rune in between
+// may be cut, and the sound change at the cut lost.
+func RomanizeReader(r io.Reader) io.Reader {
+	return newReader(r, Romanize, romanizeBoundary)
+}
@@ -0,0 +1,182 @@
+package hangul
+
+import "strings"
+
+// Revised Romanization
+
+// 국어의 로마자 표기법(2000)은 글자가 아니라 소리를 옮긴다. 그래서 음절을 하나씩
+// 바꾸면 안 되고, 앞 음절의 받침과 뒤 음절의 초성이 만나서 나는 소리를 먼저 정한다.
+//   연음     한국어 → 한구거 hangugeo, 앉아 → 안자 anja
+//   구개음화 같이 → 가치 gachi, 굳히다 → 구치다 guchida
+//   비음화   합니다 → 함니다 hamnida, 독립문 → 동님문 dongnimmun
+//   유음화   신라 → 실라 silla, 설날 → 설랄 seollal
+//   ㄹ의 비음화 종로 → 종노 jongno
+//   거센소리 좋고 → 조코 joko
+// 체언에서 ㄱ, ㄷ, ㅂ 뒤의 ㅎ은 밝혀 적는다(묵호 Mukho). 품사를 알 수 없으므로 항상 이 규칙을 따른다.
+// 된소리되기는 표기에 반영하지 않는다(압구정 apgujeong).
+
+var initialRoman = map[rune]string{
+	'ㄱ': "g", 'ㄲ': "kk", 'ㄴ': "n", 'ㄷ': "d", 'ㄸ': "tt", 'ㄹ': "r", 'ㅁ': "m",
+	'ㅂ': "b", 'ㅃ': "pp", 'ㅅ': "s", 'ㅆ': "ss", 'ㅇ': "", 'ㅈ': "j", 'ㅉ': "jj",
+	'ㅊ': "ch", 'ㅋ': "k", 'ㅌ': "t", 'ㅍ': "p", 'ㅎ': "h",
+}
+
+// medials in order, ㅏ to ㅣ
+var vowelRoman = []string{
+	"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae",
+	"oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i",
+}
+
+// 받침은 음절 끝에서 일곱 소리 중 하나로 난다(대표음).
+var finalSound = map[rune]rune{
+	'ㄱ': 'ㄱ', 'ㄲ': 'ㄱ', 'ㅋ': 'ㄱ', 'ㄳ': 'ㄱ', 'ㄺ': 'ㄱ',
+	'ㄴ': 'ㄴ', 'ㄵ': 'ㄴ', 'ㄶ': 'ㄴ',
+	'ㄷ': 'ㄷ', 'ㅅ': 'ㄷ', 'ㅆ': 'ㄷ', 'ㅈ': 'ㄷ', 'ㅊ': 'ㄷ', 'ㅌ': 'ㄷ', 'ㅎ': 'ㄷ',
+	'ㄹ': 'ㄹ', 'ㄼ': 'ㄹ', 'ㄽ': 'ㄹ', 'ㄾ': 'ㄹ', 'ㅀ': 'ㄹ',
+	'ㅁ': 'ㅁ', 'ㄻ': 'ㅁ',
+	'ㅂ': 'ㅂ', 'ㅍ': 'ㅂ', 'ㅄ': 'ㅂ', 'ㄿ': 'ㅂ',
+	'ㅇ': 'ㅇ',
+}
+
+var finalRoman = map[rune]string{
+	'ㄱ': "k", 'ㄴ': "n", 'ㄷ': "t", 'ㄹ': "l", 'ㅁ': "m", 'ㅂ': "p", 'ㅇ': "ng",
+}
+
+// 겹받침의 앞, 뒤 자음. 뒤에 모음이 오면 뒤 자음이 넘어간다.
+var finalSplit = map[rune][2]rune{
+	'ㄳ': {'ㄱ', 'ㅅ'}, 'ㄵ': {'ㄴ', 'ㅈ'}, 'ㄶ': {'ㄴ', 'ㅎ'}, 'ㄺ': {'ㄹ', 'ㄱ'},
+	'ㄻ': {'ㄹ', 'ㅁ'}, 'ㄼ': {'ㄹ', 'ㅂ'}, 'ㄽ': {'ㄹ', 'ㅅ'}, 'ㄾ': {'ㄹ', 'ㅌ'},
+	'ㄿ': {'ㄹ', 'ㅍ'}, 'ㅀ': {'ㄹ', 'ㅎ'}, 'ㅄ': {'ㅂ', 'ㅅ'},
+}
+
+// Romanize transcribes the Hangul in s under the Revised Romanization of
+// Korean, in lower case: "한국어" → "hangugeo", "신라" → "silla". Sound
+// changes are applied within each run of Hangul syllables, not across
+// spaces or punctuation. Other runes are kept, and s may be NFC or NFD.
+func Romanize(s string) string {
+	s = NFC(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	var run []Jamo
+	for _, r := range s {
+		if IsSyllable(r) {
+			j, _ := Decompose(r)
+			run = append(run, j.Compat())
+			continue
+		}
+		romanizeRun(&b, run)
+		run = run[:0]
+		b.WriteRune(r)
+	}
+	romanizeRun(&b, run)
+	return b.String()
+}
+
+// romanizeRun writes a run of syllables, given as compatibility jamo.
+func romanizeRun(b *strings.Builder, run []Jamo) {
+	for i := 0; i+1 < len(run); i++ {
+		run[i].Final, run[i+1].Initial = assimilate(run[i].Final, run[i+1].Initial, run[i+1].Medial)
+	}
+	for i, j := range run {
+		if j.Initial == 'ㄹ' && i > 0 && run[i-1].Final == 'ㄹ' {
+			b.WriteString("l") // ㄹㄹ은 ll
+		} else {
+			b.WriteString(initialRoman[j.Initial])
+		}
+		b.WriteString(vowelRoman[j.Medial-compatVowelBase])
+		if j.Final != 0 {
+			b.WriteString(finalRoman[finalSound[j.Final]])
+		}
+	}
+}
+
+// assimilate returns the sounds of final t and the next initial l, with
+// v the next vowel. Either result may be a jamo that is not written that
+// way, such as 'ㅇ' for the ㄱ of 국물 (궁물).
+func assimilate(t, l, v rune) (rune, rune) {
+	if t == 0 {
+		return t, l
+	}
+
+	// 연음: 뒤 음절이 모음으로 시작하면 받침이 넘어간다
+	if l == 'ㅇ' {
+		if p, ok := finalSplit[t]; ok {
+			if p[1] == 'ㅎ' {
+				return 0, p[0] // 많아 → 마나
+			}
+			return p[0], p[1] // 읽어 → 일거
+		}
+		switch t {
+		case 'ㅇ':
+			return t, l
+		case 'ㅎ':
+			return 0, l // 좋아 → 조아
+		case 'ㄷ':
+			if v == 'ㅣ' {
+				return 0, 'ㅈ' // 굳이 → 구지
+			}
+		case 'ㅌ':
+			if v == 'ㅣ' {
+				return 0, 'ㅊ' // 같이 → 가치
+			}
+		}
+		return 0, t
+	}
+
+	// 거센소리: ㅎ + ㄱ, ㄷ, ㅈ → ㅋ, ㅌ, ㅊ
+	if t == 'ㅎ' || t == 'ㄶ' || t == 'ㅀ' {
+		var rest rune
+		if t != 'ㅎ' {
+			rest = finalSplit[t][0]
+		}
+		switch l {
+		case 'ㄱ':
+			return rest, 'ㅋ'
+		case 'ㄷ':
+			return rest, 'ㅌ'
+		case 'ㅈ':
+			return rest, 'ㅊ'
+		}
+		if t == 'ㅎ' {
+			if l == 'ㄴ' {
+				return 'ㄴ', l // 놓는 → 논는
+			}
+			return 0, l // 좋소 → 조소
+		}
+		t = rest
+	}
+
+	if t == 'ㄷ' && l == 'ㅎ' && v == 'ㅣ' {
+		return 0, 'ㅊ' // 굳히다 → 구치다
+	}
+
+	t = finalSound[t]
+	switch {
+	case l == 'ㄹ' && (t == 'ㄴ' || t == 'ㄹ'), l == 'ㄴ' && t == 'ㄹ':
+		return 'ㄹ', 'ㄹ' // 신라 → 실라, 설날 → 설랄
+	case l == 'ㄹ':
+		l = 'ㄴ' // 종로 → 종노, 백리 → 백니 → 뱅니
+	}
+	if l == 'ㄴ' || l == 'ㅁ' {
+		switch t {
+		case 'ㄱ':
+			t = 'ㅇ' // 백마 → 뱅마
+		case 'ㄷ':
+			t = 'ㄴ' // 걷는 → 건는
+		case 'ㅂ':
+			t = 'ㅁ' // 합니다 → 함니다
+		}
+	}
+	return t, l
+}
+
+// romanizeBoundary reports whether Romanize can cut a string before r
+// without changing the result: sound changes never cross it.
+func romanizeBoundary(prev, r rune) bool {
+	return !isHangul(prev) || !isHangul(r)
+}
+
+func isHangul(r rune) bool {
+	return IsSyllable(r) || r >= lBase && r < lBase+lCount ||
+		r >= vBase && r < vBase+vCount || r > tBase && r < tBase+tCount
+}
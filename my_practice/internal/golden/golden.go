@@ -0,0 +1,74 @@
+// Package golden compares the output of a test with a golden file, the
+// output recorded the last time it was known to be right.
+//
+// Golden files live in the testdata directory of the package under test.
+// Only utils uses them. After changing output on purpose, rewrite them with
+//
+//	go test ./utils -update
+//
+// and read the diff before committing it. Only _test.go files import this
+// package, so the my_practice binary does not link the testing package.
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata from the current output")
+
+// Path returns the golden file for name: testdata/<name>.golden.
+func Path(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+// Check compares got with the golden file for name and reports a test
+// error listing the lines that differ. With -update it writes got to the
+// file instead.
+func Check(t testing.TB, name string, got []byte) {
+	t.Helper()
+	path := Path(name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test ./utils -update to create it)", err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("output differs from %s (run go test ./utils -update if the change is intended):\n%s", path, Diff(want, got))
+	}
+}
+
+// Diff lists the lines that differ at the same position, which is enough
+// to spot what changed in short output.
+func Diff(want, got []byte) string {
+	w := bytes.Split(want, []byte("\n"))
+	g := bytes.Split(got, []byte("\n"))
+	var b strings.Builder
+	for i := 0; i < len(w) || i < len(g); i++ {
+		var wl, gl []byte
+		if i < len(w) {
+			wl = w[i]
+		}
+		if i < len(g) {
+			gl = g[i]
+		}
+		if !bytes.Equal(wl, gl) {
+			fmt.Fprintf(&b, "%4d - %s\n", i+1, wl)
+			fmt.Fprintf(&b, "%4d + %s\n", i+1, gl)
+		}
+	}
+	return b.String()
+}
@@ -1,8 +1,30 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"image/color"
+	"io"
+	"io/fs"
+	"math/rand"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"go-study/my_practice/hangul"
+	"go-study/my_practice/pic"
+	"go-study/my_practice/tictactoe"
 	"go-study/my_practice/utils"
+	"go-study/my_practice/wordcount"
 )
 
 // Packages, variables, and functions
@@ -68,74 +90,668 @@ import (
 // 	fmt.Println(i, c, python, java)
 // }
 
+const usage = `usage:
+  my_practice list [--chapter N]
+  my_practice run ID...
+  my_practice run --chapter N
+  my_practice run --all
+  (run also takes --now TIME to pretend the current time is TIME)
+  my_practice pic [-formula F] [-model gray|rgba] [-format term|plain|png|pgm|text] [-size N] [-o FILE]
+  my_practice wordcount [-top K] [-stopwords english|FILE] [-keep-case] [-j N] [-n N] [-tfidf] [FILE|DIR...]
+  my_practice index [-o FILE] [-stopwords english|FILE] [-keep-case] FILE|DIR...
+  my_practice query [-i FILE] QUERY
+  my_practice hangul [-to roman|choseong|nfc|nfd] [TEXT...]
+  my_practice play [-n N] [-k K] [-x human|ai] [-o human|ai] [-depth D] [-seed S] [-save FILE]
+  my_practice replay [-delay D] [-to text|json] [FILE]
+`
+
 func main() {
-	// utils.Practice1_1()
-	// utils.Practice1_2()
-	// utils.Practice1_3()
-	// utils.Practice1_4()
-	// utils.Practice1_5()
-
-	// utils.Practice2_1()
-	// utils.Practice2_2()
-	// utils.Practice2_3()
-	// utils.Practice2_4()
-	// utils.Practice2_5()
-	// utils.Practice2_6()
-	// utils.Practice2_7()
-	// utils.Practice2_8()
-	// utils.Practice2_9()
-	// utils.Practice2_10()
-	// utils.Practice2_11()
-
-	// utils.Practice3_1()
-	// utils.Practice3_2()
-	// utils.Practice3_3()
-	// utils.Practice3_4()
-	// utils.Practice3_5()
-	// utils.Practice3_6()
-	// utils.Practice3_7()
-	// utils.Practice3_8()
-	// utils.Practice3_9()
-	// utils.Practice3_10()
-	// utils.Practice3_11()
-	// utils.Practice3_12()
-	// utils.Practice3_13()
-	// utils.Practice3_14()
-	// utils.Practice3_15()
-	// utils.Practice3_16()
-	// utils.Practice3_17()
-	// utils.Practice3_19()
-	// utils.Practice3_20()
-	// utils.Practice3_21()
-	// utils.Practice3_22()
-	// utils.Practice3_23()
-	// utils.Practice3_24()
-	// utils.Practice3_25()
-	// utils.Practice3_26()
-
-	// utils.Practice4_1()
-	// utils.Practice4_2()
-	// utils.Practice4_3()
-	// utils.Practice4_4()
-	// utils.Practice4_5()
-	// utils.Practice4_6()
-	// utils.Practice4_7()
-	// utils.Practice4_8()
-	// utils.Practice4_9()
-	// utils.Practice4_10()
-	// utils.Practice4_11()
-	// utils.Practice4_12()
-	// utils.Practice4_13()
-	// utils.Practice4_14()
-	// utils.Practice4_15()
-	// utils.Practice4_16()
-	// utils.Practice4_17()
-	// utils.Practice4_18()
-	// utils.Practice4_19()
-	// utils.Practice4_20()
-	// utils.Practice4_21()
-	// utils.Practice4_22()
-
-	fmt.Println(utils.ToUpper1("Hello 월드!"))
-	fmt.Println(utils.ToUpper2("Hello 월드!"))
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "list":
+		err = listCmd(args)
+	case "run":
+		err = runCmd(args)
+	case "pic":
+		err = picCmd(args)
+	case "wordcount":
+		err = wordcountCmd(args)
+	case "index":
+		err = indexCmd(args)
+	case "query":
+		err = queryCmd(args)
+	case "hangul":
+		err = hangulCmd(args)
+	case "play":
+		err = playCmd(args)
+	case "replay":
+		err = replayCmd(args)
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		err = fmt.Errorf("unknown command %q", cmd)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "my_practice:", err)
+		os.Exit(1)
+	}
+}
+
+func listCmd(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	chapter := fs.Int("chapter", -1, "only list exercises of this chapter")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	list := utils.Exercises()
+	if *chapter >= 0 {
+		list = utils.Chapter(*chapter)
+	}
+	for _, e := range list {
+		fmt.Printf("%-5s %s\n", e.ID, e.Title)
+	}
+	return nil
+}
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	chapter := fs.Int("chapter", -1, "run every exercise of this chapter")
+	all := fs.Bool("all", false, "run every exercise")
+	now := fs.String("now", "", "pretend the current time is `TIME` (RFC 3339, \"2006-01-02 15:04\" or \"2006-01-02\")")
+	ids, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if *now != "" {
+		t, err := parseNow(*now)
+		if err != nil {
+			return err
+		}
+		utils.SetClock(utils.NewFakeClock(t))
+	}
+
+	var list []utils.Exercise
+	switch {
+	case *all:
+		list = utils.Exercises()
+	case *chapter >= 0:
+		list = utils.Chapter(*chapter)
+		if len(list) == 0 {
+			return fmt.Errorf("no exercises in chapter %d", *chapter)
+		}
+	case len(ids) > 0:
+		for _, id := range ids {
+			e, ok := utils.Lookup(id)
+			if !ok {
+				return fmt.Errorf("no exercise %q (see: my_practice list)", id)
+			}
+			list = append(list, e)
+		}
+	default:
+		return fmt.Errorf("run: need an exercise ID, --chapter or --all")
+	}
+
+	// 여러 개를 실행할 때만 제목을 붙인다
+	for _, e := range list {
+		if len(list) > 1 {
+			fmt.Printf("=== %s %s\n", e.ID, e.Title)
+		}
+		// 일부러 panic을 내는 예제도 있으므로 panic 메시지만 출력하고 계속 진행
+		if err := e.RunTo(os.Stdout); err != nil {
+			fmt.Println(err)
+		}
+	}
+	return nil
+}
+
+// parseInterspersed parses fs like fs.Parse but also accepts flags after
+// positional arguments, so both "run --now X 2_10" and "run 2_10 --now X" work.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// --now에 받는 시각 형식. 시간대가 없으면 local time으로 해석한다.
+var nowLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+func parseNow(s string) (time.Time, error) {
+	for _, layout := range nowLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("--now: cannot parse %q as a time", s)
+}
+
+func picCmd(args []string) error {
+	fs := flag.NewFlagSet("pic", flag.ContinueOnError)
+	formula := fs.String("formula", "(x+y)/2", "pixel formula: "+strings.Join(formulaNames(), ", "))
+	model := fs.String("model", "gray", "color model: gray, or rgba for the tour's blue tint")
+	format := fs.String("format", "term", "output format: term, plain, png, pgm or text (the tour's IMAGE: line)")
+	size := fs.Int("size", pic.Size, "width and height in pixels")
+	cols := fs.Int("cols", 64, "preview width in characters (term and plain)")
+	output := fs.String("o", "", "write to `FILE` instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Image.Bounds는 음수 크기를 뒤집어 버리므로 여기서 막는다
+	if *size < 1 {
+		fs.Usage()
+		return fmt.Errorf("pic: -size %d: want at least 1", *size)
+	}
+	if *cols < 1 {
+		fs.Usage()
+		return fmt.Errorf("pic: -cols %d: want at least 1", *cols)
+	}
+
+	f, ok := utils.PicFormulas3_18[*formula]
+	if !ok {
+		return fmt.Errorf("pic: unknown formula %q (want one of %s)", *formula, strings.Join(formulaNames(), ", "))
+	}
+	m := utils.Image{W: *size, H: *size, Pixel: f, Model: color.GrayModel}
+	switch *model {
+	case "gray":
+	case "rgba":
+		m.Model = color.RGBAModel
+	default:
+		return fmt.Errorf("pic: unknown color model %q", *model)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	switch *format {
+	case "term":
+		return pic.Preview(w, m, *cols)
+	case "plain":
+		return pic.PreviewPlain(w, m, *cols)
+	case "png":
+		return pic.WritePNG(w, m)
+	case "pgm":
+		return pic.WritePGM(w, m)
+	case "text":
+		return pic.ShowImage(w, m)
+	}
+	return fmt.Errorf("pic: unknown format %q", *format)
+}
+
+func formulaNames() []string {
+	names := make([]string, 0, len(utils.PicFormulas3_18))
+	for name := range utils.PicFormulas3_18 {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func wordcountCmd(args []string) error {
+	fs := flag.NewFlagSet("wordcount", flag.ContinueOnError)
+	top := fs.Int("top", 20, "print the `K` most frequent words (0 for all)")
+	stop := fs.String("stopwords", "", "skip stopwords: \"english\" for the built-in list, or a `FILE` with one word per line")
+	keepCase := fs.Bool("keep-case", false, "count words that differ in case separately")
+	jobs := fs.Int("j", runtime.NumCPU(), "count files with up to `N` goroutines")
+	ngram := fs.Int("n", 1, "count sequences of `N` words instead of single words")
+	tfidf := fs.Bool("tfidf", false, "print the top words of each file by TF-IDF instead of one total")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	opts, err := wordcountOptions(*stop, *keepCase)
+	if err != nil {
+		return err
+	}
+
+	paths, err := expandDirs(fs.Args())
+	if err != nil {
+		return err
+	}
+	if *tfidf {
+		return tfidfCmd(paths, *top, opts)
+	}
+
+	var c *wordcount.Counter
+	switch {
+	case len(paths) == 0:
+		c, err = wordcount.CountNGrams(os.Stdin, *ngram, opts...)
+	case *ngram != 1:
+		// n-gram은 파일 경계를 넘지 않도록 파일마다 따로 센다
+		c = wordcount.New(opts...)
+		for _, p := range paths {
+			var fc *wordcount.Counter
+			if fc, err = countNGramsFile(p, *ngram, opts); err != nil {
+				break
+			}
+			c.Merge(fc)
+		}
+	default:
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		c, err = wordcount.CountFiles(ctx, paths, *jobs, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range c.Top(*top) {
+		fmt.Printf("%7d %s\n", e.Count, e.Word)
+	}
+	return nil
+}
+
+func wordcountOptions(stop string, keepCase bool) ([]wordcount.Option, error) {
+	var opts []wordcount.Option
+	if keepCase {
+		opts = append(opts, wordcount.KeepCase())
+	}
+	switch stop {
+	case "":
+	case "english":
+		opts = append(opts, wordcount.WithStopwords(wordcount.EnglishStopwords...))
+	default:
+		b, err := os.ReadFile(stop)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, wordcount.WithStopwords(strings.Fields(string(b))...))
+	}
+	return opts, nil
+}
+
+func countNGramsFile(path string, n int, opts []wordcount.Option) (*wordcount.Counter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return wordcount.CountNGrams(f, n, opts...)
+}
+
+func tfidfCmd(paths []string, top int, opts []wordcount.Option) error {
+	if len(paths) == 0 {
+		return errors.New("-tfidf needs at least one file")
+	}
+	c := wordcount.NewCorpus(opts...)
+	for _, p := range paths {
+		if err := addFile(p, c.Add); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < c.Len(); i++ {
+		fmt.Printf("%s (%d words)\n", c.Doc(i).Name, c.Doc(i).Total)
+		for _, s := range c.Top(i, top) {
+			fmt.Printf("  %.4f %s\n", s.Score, s.Term)
+		}
+	}
+	return nil
+}
+
+// addFile passes the file at path to add, which is Corpus.Add or Index.Add.
+func addFile(path string, add func(string, io.Reader) (int, error)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = add(path, f)
+	return err
+}
+
+func indexCmd(args []string) error {
+	fs := flag.NewFlagSet("index", flag.ContinueOnError)
+	output := fs.String("o", "index.wcix", "write the index to `FILE`")
+	stop := fs.String("stopwords", "", "leave out stopwords: \"english\" for the built-in list, or a `FILE` with one word per line")
+	keepCase := fs.Bool("keep-case", false, "index words that differ in case separately")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	opts, err := wordcountOptions(*stop, *keepCase)
+	if err != nil {
+		return err
+	}
+	paths, err := expandDirs(fs.Args())
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.New("index needs at least one file")
+	}
+
+	ix := wordcount.NewIndex(opts...)
+	for _, p := range paths {
+		if err := addFile(p, ix.Add); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	n, err := ix.WriteTo(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d files, %d terms, %d bytes\n", *output, len(ix.Docs()), len(ix.Terms()), n)
+	return nil
+}
+
+func queryCmd(args []string) error {
+	fs := flag.NewFlagSet("query", flag.ContinueOnError)
+	input := fs.String("i", "index.wcix", "read the index from `FILE`")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("query needs a query")
+	}
+	f, err := os.Open(*input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ix, err := wordcount.ReadIndex(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *input, err)
+	}
+
+	matches, err := ix.Query(strings.Join(fs.Args(), " "))
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		fmt.Println(m.Name)
+		terms := make([]string, 0, len(m.Positions))
+		for t := range m.Positions {
+			terms = append(terms, t)
+		}
+		sort.Strings(terms)
+		for _, t := range terms {
+			fmt.Printf("  %s %v\n", t, m.Positions[t])
+		}
+	}
+	return nil
+}
+
+var hangulReaders = map[string]func(io.Reader) io.Reader{
+	"roman":    hangul.RomanizeReader,
+	"choseong": hangul.ChoseongReader,
+	"nfc":      hangul.NFCReader,
+	"nfd":      hangul.NFDReader,
+}
+
+// hangulCmd converts TEXT, or stdin as a stream if there is none.
+func hangulCmd(args []string) error {
+	fs := flag.NewFlagSet("hangul", flag.ContinueOnError)
+	to := fs.String("to", "roman", "convert to `FORM`: roman, choseong, nfc or nfd")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	conv, ok := hangulReaders[*to]
+	if !ok {
+		return fmt.Errorf("unknown -to %q", *to)
+	}
+
+	in := io.Reader(os.Stdin)
+	if fs.NArg() > 0 {
+		in = strings.NewReader(strings.Join(fs.Args(), " ") + "\n")
+	}
+	_, err := io.Copy(os.Stdout, conv(in))
+	return err
+}
+
+// playCmd plays tic-tac-toe in the terminal. Each side is a human, who
+// types moves such as b2 (or undo, hint, quit), or the AI.
+func playCmd(args []string) (err error) {
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	n := fs.Int("n", 3, "board size `N`×N")
+	k := fs.Int("k", 0, "`K` in a row wins (default N, at most 5)")
+	xSide := fs.String("x", "human", "who plays X: human or ai")
+	oSide := fs.String("o", "ai", "who plays O: human or ai")
+	depth := fs.Int("depth", -1, "AI search `depth`, 0 to search to the end (default 0 up to 4×4, else 3)")
+	seed := fs.Int64("seed", 0, "vary the AI's moves with random `seed` S (0 always plays the same)")
+	save := fs.String("save", "", "write the game record to `FILE` when the game ends or you quit")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *k == 0 {
+		*k = min(*n, 5)
+	}
+	if *depth < 0 {
+		*depth = 0
+		if *n > 4 {
+			*depth = 3
+		}
+	}
+	b, err := tictactoe.New(*n, *k)
+	if err != nil {
+		return err
+	}
+	opts := []tictactoe.Option{tictactoe.WithDepth(*depth)}
+	if *seed != 0 {
+		opts = append(opts, tictactoe.WithRand(rand.New(rand.NewSource(*seed))))
+	}
+	ai, err := tictactoe.NewAI(opts...)
+	if err != nil {
+		return err
+	}
+	human := map[tictactoe.Player]bool{}
+	for p, side := range map[tictactoe.Player]string{tictactoe.X: *xSide, tictactoe.O: *oSide} {
+		switch side {
+		case "human":
+			human[p] = true
+		case "ai":
+		default:
+			return fmt.Errorf("-%s: want human or ai, not %q", strings.ToLower(p.String()), side)
+		}
+	}
+
+	if *save != "" {
+		defer func() {
+			rec, werr := tictactoe.NewRecord(b,
+				tictactoe.Tag{Name: "X", Value: *xSide},
+				tictactoe.Tag{Name: "O", Value: *oSide},
+				tictactoe.Tag{Name: "Date", Value: time.Now().Format("2006-01-02")})
+			if werr == nil {
+				werr = writeRecord(*save, rec)
+			}
+			if err == nil {
+				err = werr
+			}
+		}()
+	}
+
+	in := bufio.NewScanner(os.Stdin)
+	fmt.Printf("%d×%d, %d in a row\n", *n, *n, *k)
+	for b.State() == tictactoe.InProgress {
+		fmt.Print(b.Labeled())
+		p := b.Turn()
+		if !human[p] {
+			r, err := ai.Best(b)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%v plays %v\n", p, r)
+			if err := b.Play(r.Move); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Printf("%v to move: ", p)
+		if !in.Scan() {
+			fmt.Println()
+			if err := in.Err(); err != nil {
+				return err
+			}
+			return errors.New("input ended before the game did")
+		}
+		switch cmd := strings.TrimSpace(in.Text()); cmd {
+		case "quit", "q":
+			return nil
+		case "hint":
+			r, err := ai.Best(b)
+			if err != nil {
+				return err
+			}
+			fmt.Println("hint:", r)
+		case "undo":
+			// AI가 둔 수까지 무르고 다시 사람 차례로 돌아간다
+			for b.Undo() && !human[b.Turn()] {
+			}
+		default:
+			m, err := tictactoe.ParseMove(cmd)
+			if err == nil {
+				err = b.Play(m)
+			}
+			if err != nil {
+				fmt.Println(err)
+			}
+		}
+	}
+	fmt.Print(b.Labeled())
+	fmt.Println(b.State())
+	return nil
+}
+
+// writeRecord saves rec to path, as JSON if path ends in .json.
+func writeRecord(path string, rec *tictactoe.Record) error {
+	var buf bytes.Buffer
+	if strings.HasSuffix(path, ".json") {
+		js, err := json.MarshalIndent(rec, "", "  ")
+		if err != nil {
+			return err
+		}
+		buf.Write(append(js, '\n'))
+	} else if _, err := rec.WriteTo(&buf); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// replayCmd steps through a saved game, printing the board after each
+// move, or converts the record with -to.
+func replayCmd(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	delay := fs.Duration("delay", 0, "wait `D` between moves")
+	to := fs.String("to", "", "instead of replaying, print the record as text or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	in := io.Reader(os.Stdin)
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	default:
+		return errors.New("replay takes at most one file")
+	}
+	rec, err := tictactoe.ParseRecord(in)
+	if err != nil {
+		return err
+	}
+
+	switch *to {
+	case "":
+	case "text":
+		_, err := rec.WriteTo(os.Stdout)
+		return err
+	case "json":
+		js, err := json.MarshalIndent(rec, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Printf("%s\n", js)
+		return err
+	default:
+		return fmt.Errorf("unknown -to %q", *to)
+	}
+
+	fmt.Printf("%d×%d, %d in a row\n", rec.Size, rec.Size, rec.K)
+	for _, t := range rec.Tags {
+		fmt.Printf("%s: %s\n", t.Name, t.Value)
+	}
+	b, err := tictactoe.New(rec.Size, rec.K)
+	if err != nil {
+		return err
+	}
+	for i, m := range rec.Moves {
+		if i > 0 && *delay > 0 {
+			time.Sleep(*delay)
+		}
+		p := b.Turn()
+		if err := b.Play(m); err != nil {
+			return err
+		}
+		// Practice3_14처럼 한 줄씩 strings.Join으로 보여 준다
+		fmt.Printf("\n%d. %v %v\n", i+1, p, m)
+		for _, row := range b.Rows() {
+			fmt.Println(strings.Join(row, " "))
+		}
+	}
+	fmt.Println()
+	fmt.Println(b.State())
+	return nil
+}
+
+// expandDirs replaces every directory in paths with the regular files
+// below it, so a whole corpus can be passed as one argument.
+func expandDirs(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		err = filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.Type().IsRegular() {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
 }
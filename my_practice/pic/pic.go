@@ -0,0 +1,147 @@
+// Package pic is a local stand-in for golang.org/x/tour/pic.
+// It turns a tour-style generator such as utils.Pic3_18 into an
+// image.Image and writes it as PNG, PGM, the tour's base64 "IMAGE:" text,
+// or a preview made of Unicode blocks for the terminal.
+package pic
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+)
+
+// Size is the width and height Show asks the generator for, as in the tour.
+const Size = 256
+
+// FromFunc calls f(dx, dy) and copies the result into a grayscale image.
+// f must return dy rows of dx values each, like the tour's Pic exercise.
+func FromFunc(f func(dx, dy int) [][]uint8, dx, dy int) (*image.Gray, error) {
+	rows := f(dx, dy)
+	if len(rows) != dy {
+		return nil, fmt.Errorf("pic: got %d rows, want %d", len(rows), dy)
+	}
+	m := image.NewGray(image.Rect(0, 0, dx, dy))
+	for y, row := range rows {
+		if len(row) != dx {
+			return nil, fmt.Errorf("pic: row %d has %d values, want %d", y, len(row), dx)
+		}
+		copy(m.Pix[y*m.Stride:], row)
+	}
+	return m, nil
+}
+
+// Show writes f rendered at Size×Size in the tour's format:
+// "IMAGE:" followed by the base64-encoded PNG and a newline.
+func Show(w io.Writer, f func(dx, dy int) [][]uint8) error {
+	m, err := FromFunc(f, Size, Size)
+	if err != nil {
+		return err
+	}
+	return ShowImage(w, m)
+}
+
+// ShowImage writes m as "IMAGE:<base64 PNG>\n".
+func ShowImage(w io.Writer, m image.Image) error {
+	var b strings.Builder
+	b.WriteString("IMAGE:")
+	enc := base64.NewEncoder(base64.StdEncoding, &b)
+	if err := png.Encode(enc, m); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	b.WriteByte('\n')
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WritePNG writes m as a PNG file.
+func WritePNG(w io.Writer, m image.Image) error {
+	return png.Encode(w, m)
+}
+
+// WritePGM writes m as a binary (P5) PGM file, converting to gray first.
+func WritePGM(w io.Writer, m image.Image) error {
+	bw := bufio.NewWriter(w)
+	r := m.Bounds()
+	fmt.Fprintf(bw, "P5\n%d %d\n255\n", r.Dx(), r.Dy())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			bw.WriteByte(color.GrayModel.Convert(m.At(x, y)).(color.Gray).Y)
+		}
+	}
+	return bw.Flush()
+}
+
+// 밝기 순서대로 나열한 shade 문자
+var shades = []rune(" ░▒▓█")
+
+// PreviewPlain draws m cols characters wide using the shade characters
+// " ░▒▓█", one character per sampled pixel. Terminal cells are roughly
+// twice as tall as they are wide, so every other row is skipped.
+func PreviewPlain(w io.Writer, m image.Image, cols int) error {
+	bw := bufio.NewWriter(w)
+	r := m.Bounds()
+	rows := max(scaled(r.Dy(), r.Dx(), cols)/2, 1)
+	for j := 0; j < rows; j++ {
+		for i := 0; i < cols; i++ {
+			g := gray(m, r, i, cols, j, rows)
+			bw.WriteRune(shades[int(g)*len(shades)/256])
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// Preview draws m cols characters wide with 24-bit ANSI colors.
+// Each cell is an upper half block "▀" whose foreground is the upper pixel
+// and whose background is the lower one, so one line covers two pixel rows.
+func Preview(w io.Writer, m image.Image, cols int) error {
+	bw := bufio.NewWriter(w)
+	r := m.Bounds()
+	rows := scaled(r.Dy(), r.Dx(), cols)
+	for j := 0; j < rows; j += 2 {
+		for i := 0; i < cols; i++ {
+			top := at(m, r, i, cols, j, rows)
+			bottom := top
+			if j+1 < rows {
+				bottom = at(m, r, i, cols, j+1, rows)
+			}
+			fmt.Fprintf(bw, "\x1b[38;2;%d;%d;%dm\x1b[48;2;%d;%d;%dm▀",
+				top.R, top.G, top.B, bottom.R, bottom.G, bottom.B)
+		}
+		bw.WriteString("\x1b[0m\n")
+	}
+	return bw.Flush()
+}
+
+// scaled returns how many rows keep the aspect ratio of an image
+// dy tall and dx wide when it is drawn cols wide. It is at least 1.
+func scaled(dy, dx, cols int) int {
+	if dx == 0 {
+		return 0
+	}
+	if n := dy * cols / dx; n > 0 {
+		return n
+	}
+	return 1
+}
+
+// at samples the pixel of m nearest to cell (i, j) of a cols×rows grid.
+func at(m image.Image, r image.Rectangle, i, cols, j, rows int) color.RGBA {
+	x := r.Min.X + i*r.Dx()/cols
+	y := r.Min.Y + j*r.Dy()/rows
+	return color.RGBAModel.Convert(m.At(x, y)).(color.RGBA)
+}
+
+func gray(m image.Image, r image.Rectangle, i, cols, j, rows int) uint8 {
+	x := r.Min.X + i*r.Dx()/cols
+	y := r.Min.Y + j*r.Dy()/rows
+	return color.GrayModel.Convert(m.At(x, y)).(color.Gray).Y
+}
@@ -0,0 +1,130 @@
+package pic
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// xor is the tour's x^y picture.
+func xor(dx, dy int) [][]uint8 {
+	rows := make([][]uint8, dy)
+	for y := range rows {
+		rows[y] = make([]uint8, dx)
+		for x := range rows[y] {
+			rows[y][x] = uint8(x ^ y)
+		}
+	}
+	return rows
+}
+
+func TestFromFunc(t *testing.T) {
+	m, err := FromFunc(xor, 3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Bounds() != image.Rect(0, 0, 3, 2) {
+		t.Errorf("bounds %v", m.Bounds())
+	}
+	for _, c := range []struct{ x, y, want int }{{0, 0, 0}, {2, 0, 2}, {1, 1, 0}, {2, 1, 3}} {
+		if got := m.GrayAt(c.x, c.y).Y; int(got) != c.want {
+			t.Errorf("pixel (%d, %d) = %d, want %d", c.x, c.y, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		name string
+		f    func(dx, dy int) [][]uint8
+		want string
+	}{
+		{"too few rows", func(dx, dy int) [][]uint8 { return xor(dx, dy-1) }, "pic: got 1 rows, want 2"},
+		{"short row", func(dx, dy int) [][]uint8 { r := xor(dx, dy); r[1] = r[1][:1]; return r }, "pic: row 1 has 1 values, want 3"},
+	} {
+		if _, err := FromFunc(c.f, 3, 2); err == nil || err.Error() != c.want {
+			t.Errorf("%s: err = %v, want %s", c.name, err, c.want)
+		}
+	}
+}
+
+// Show의 출력은 base64를 풀면 원래 그림과 같은 PNG다.
+func TestShow(t *testing.T) {
+	var out bytes.Buffer
+	if err := Show(&out, xor); err != nil {
+		t.Fatal(err)
+	}
+	s := out.String()
+	if !strings.HasPrefix(s, "IMAGE:") || !strings.HasSuffix(s, "\n") || strings.Count(s, "\n") != 1 {
+		t.Fatalf("Show wrote %.20q…, want one IMAGE: line", s)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSuffix(strings.TrimPrefix(s, "IMAGE:"), "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Bounds().Dx() != Size || m.Bounds().Dy() != Size {
+		t.Errorf("bounds %v, want %d×%d", m.Bounds(), Size, Size)
+	}
+	if got := color.GrayModel.Convert(m.At(5, 3)).(color.Gray).Y; got != 5^3 {
+		t.Errorf("pixel (5, 3) = %d, want %d", got, 5^3)
+	}
+}
+
+func TestWritePGM(t *testing.T) {
+	m, _ := FromFunc(xor, 3, 2)
+	var out bytes.Buffer
+	if err := WritePGM(&out, m); err != nil {
+		t.Fatal(err)
+	}
+	want := "P5\n3 2\n255\n\x00\x01\x02\x01\x00\x03"
+	if out.String() != want {
+		t.Errorf("WritePGM = %q, want %q", out.String(), want)
+	}
+}
+
+func TestPreviewPlain(t *testing.T) {
+	// 왼쪽 절반은 검정, 오른쪽 절반은 흰색
+	m := image.NewGray(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 4; x < 8; x++ {
+			m.SetGray(x, y, color.Gray{255})
+		}
+	}
+	for _, c := range []struct {
+		cols int
+		want string
+	}{
+		// 세로는 절반만 쓴다
+		{4, "  ██\n  ██\n"},
+		{8, "    ████\n    ████\n    ████\n    ████\n"},
+		{1, " \n"},
+	} {
+		var out bytes.Buffer
+		if err := PreviewPlain(&out, m, c.cols); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != c.want {
+			t.Errorf("PreviewPlain(%d) = %q, want %q", c.cols, out.String(), c.want)
+		}
+	}
+}
+
+func TestPreview(t *testing.T) {
+	m := image.NewRGBA(image.Rect(0, 0, 1, 2))
+	m.Set(0, 0, color.RGBA{255, 0, 0, 255})
+	m.Set(0, 1, color.RGBA{0, 0, 255, 255})
+	var out bytes.Buffer
+	if err := Preview(&out, m, 1); err != nil {
+		t.Fatal(err)
+	}
+	want := "\x1b[38;2;255;0;0m\x1b[48;2;0;0;255m▀\x1b[0m\n"
+	if out.String() != want {
+		t.Errorf("Preview = %q, want %q", out.String(), want)
+	}
+}
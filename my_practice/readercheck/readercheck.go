@@ -0,0 +1,183 @@
+// Package readercheck checks that an io.Reader follows the io.Reader
+// contract. It plays the role of golang.org/x/tour/reader.Validate for
+// MyReader4_22, rot13Reader and the readers in the transform package.
+//
+// Validate drives the reader with a cycle of buffer sizes, including
+// zero-length buffers, and reports every broken rule it sees as a Violation.
+package readercheck
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultLimit is how many bytes Validate reads before it stops.
+// Readers such as MyReader4_22 never end, so every check needs a limit.
+const DefaultLimit = 1 << 16
+
+// BufSizes are the buffer lengths handed to Read, in turn, until the
+// reader ends or the limit is reached.
+var BufSizes = []int{0, 1, 2, 3, 5, 8, 13, 64, 512, 4096}
+
+// maxEmptyReads matches the limit bufio uses before giving up with
+// io.ErrNoProgress.
+const maxEmptyReads = 100
+
+// Rule identifies which part of the io.Reader contract was broken.
+type Rule int
+
+const (
+	NegativeCount   Rule = iota // n < 0
+	CountTooLarge               // n > len(b)
+	ZeroLengthRead              // Read(b[:0]) returned n != 0
+	NoProgress                  // too many reads in a row returned 0, nil
+	EOFNotSticky                // a read after io.EOF returned data or a nil error
+	UnexpectedError             // a read returned an error other than io.EOF
+)
+
+var ruleNames = [...]string{
+	NegativeCount:   "negative count",
+	CountTooLarge:   "count exceeds buffer",
+	ZeroLengthRead:  "zero-length read returned data",
+	NoProgress:      "no progress",
+	EOFNotSticky:    "EOF not sticky",
+	UnexpectedError: "unexpected error",
+}
+
+func (r Rule) String() string {
+	if r < 0 || int(r) >= len(ruleNames) {
+		return fmt.Sprintf("Rule(%d)", int(r))
+	}
+	return ruleNames[r]
+}
+
+// Violation describes one Read call that broke a rule.
+type Violation struct {
+	Rule    Rule
+	Call    int // 1-based index of the Read call
+	BufSize int // len(b) passed to Read
+	N       int
+	Err     error
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("read #%d (len %d): %v: n=%d err=%v", v.Call, v.BufSize, v.Rule, v.N, v.Err)
+}
+
+// Error is returned by Validate when the reader broke at least one rule.
+// Violations holds the first violation of each rule, in the order seen;
+// a reader that breaks a rule once usually breaks it on every call.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "readercheck: %d violation(s)", len(e.Violations))
+	for _, v := range e.Violations {
+		b.WriteString("\n\t")
+		b.WriteString(v.String())
+	}
+	return b.String()
+}
+
+// Has reports whether e contains a violation of rule.
+func (e *Error) Has(rule Rule) bool {
+	for _, v := range e.Violations {
+		if v.Rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate reads up to DefaultLimit bytes from r and checks every call.
+// It returns nil if r behaved, or an *Error listing the violations.
+func Validate(r io.Reader) error {
+	return ValidateLimit(r, DefaultLimit)
+}
+
+// ValidateLimit is like Validate but stops after limit bytes.
+func ValidateLimit(r io.Reader, limit int64) error {
+	c := checker{r: r}
+	var total int64
+	empty := 0
+	for i := 0; total < limit; i++ {
+		size := BufSizes[i%len(BufSizes)]
+		n, err := c.read(size)
+		if n < 0 || n > size {
+			// already reported by read; count nothing for this call
+			n = 0
+		}
+
+		if size != 0 && n == 0 && err == nil {
+			empty++
+			if empty == maxEmptyReads {
+				c.report(NoProgress, size, n, err)
+				break
+			}
+		} else if size != 0 {
+			empty = 0
+		}
+		total += int64(n)
+
+		if err == io.EOF {
+			c.checkAfterEOF()
+			break
+		}
+		if err != nil {
+			c.report(UnexpectedError, size, n, err)
+			break
+		}
+	}
+	if len(c.violations) > 0 {
+		return &Error{c.violations}
+	}
+	return nil
+}
+
+type checker struct {
+	r          io.Reader
+	calls      int
+	violations []Violation
+}
+
+// read calls Read with a fresh buffer of the given size and checks the
+// count against it. A zero-length buffer only reports ZeroLengthRead.
+func (c *checker) read(size int) (int, error) {
+	c.calls++
+	n, err := c.r.Read(make([]byte, size))
+	switch {
+	case size == 0 && n != 0:
+		c.report(ZeroLengthRead, size, n, err)
+	case n < 0:
+		c.report(NegativeCount, size, n, err)
+	case n > size:
+		c.report(CountTooLarge, size, n, err)
+	}
+	return n, err
+}
+
+// checkAfterEOF makes sure the reader keeps returning 0, io.EOF once it
+// has reported the end of the stream.
+func (c *checker) checkAfterEOF() {
+	for i := 0; i < 2; i++ {
+		const size = 8
+		n, err := c.read(size)
+		if n != 0 || !errors.Is(err, io.EOF) {
+			c.report(EOFNotSticky, size, n, err)
+			return
+		}
+	}
+}
+
+func (c *checker) report(rule Rule, size, n int, err error) {
+	for _, v := range c.violations {
+		if v.Rule == rule {
+			return
+		}
+	}
+	c.violations = append(c.violations, Violation{rule, c.calls, size, n, err})
+}
@@ -0,0 +1,137 @@
+package readercheck
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// readerFunc turns a function into an io.Reader.
+type readerFunc func(b []byte) (int, error)
+
+func (f readerFunc) Read(b []byte) (int, error) { return f(b) }
+
+// endless is MyReader4_22: an endless stream of 'A'.
+var endless = readerFunc(func(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 'A'
+	}
+	return len(b), nil
+})
+
+func TestValidateGood(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		r    io.Reader
+	}{
+		{"strings.Reader", strings.NewReader("Lbh penpxrq gur pbqr!")},
+		{"empty", strings.NewReader("")},
+		{"one byte", iotest.OneByteReader(strings.NewReader("hello"))},
+		{"endless", endless},
+	} {
+		if err := Validate(c.r); err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+	}
+}
+
+func TestValidateBad(t *testing.T) {
+	fire := errors.New("disk on fire")
+	for _, c := range []struct {
+		name string
+		r    io.Reader
+		want []Violation
+	}{
+		{"negative count", readerFunc(func(b []byte) (int, error) { return -1, nil }),
+			[]Violation{{ZeroLengthRead, 1, 0, -1, nil}, {NegativeCount, 2, 1, -1, nil}, {NoProgress, 112, 1, 0, nil}}},
+		{"count too large", readerFunc(func(b []byte) (int, error) { return len(b) + 1, nil }),
+			[]Violation{{ZeroLengthRead, 1, 0, 1, nil}, {CountTooLarge, 2, 1, 2, nil}, {NoProgress, 112, 1, 0, nil}}},
+		{"zero-length read", readerFunc(func(b []byte) (int, error) {
+			if len(b) == 0 {
+				return 1, nil
+			}
+			return endless.Read(b)
+		}),
+			[]Violation{{ZeroLengthRead, 1, 0, 1, nil}}},
+		// 길이 0인 읽기는 진행이 없어도 세지 않으므로 100번째 빈 읽기는 112번째 호출이다
+		{"no progress", readerFunc(func(b []byte) (int, error) { return 0, nil }),
+			[]Violation{{NoProgress, 112, 1, 0, nil}}},
+		{"EOF not sticky", &unsticky{data: []byte("ab")},
+			[]Violation{{EOFNotSticky, 5, 8, 2, nil}}},
+		{"error", iotest.ErrReader(fire),
+			[]Violation{{UnexpectedError, 1, 0, 0, fire}}},
+	} {
+		err := Validate(c.r)
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Errorf("%s: err = %v, want an *Error", c.name, err)
+			continue
+		}
+		if len(e.Violations) != len(c.want) {
+			t.Errorf("%s: %v, want %v", c.name, e.Violations, c.want)
+			continue
+		}
+		for i, v := range e.Violations {
+			if v != c.want[i] {
+				t.Errorf("%s: violation %d = %v, want %v", c.name, i, v, c.want[i])
+			}
+		}
+		for _, v := range c.want {
+			if !e.Has(v.Rule) {
+				t.Errorf("%s: Has(%v) = false", c.name, v.Rule)
+			}
+		}
+	}
+}
+
+// unsticky returns its data and io.EOF, then starts over.
+type unsticky struct {
+	data []byte
+	off  int
+}
+
+func (u *unsticky) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if u.off == len(u.data) {
+		u.off = 0
+		return 0, io.EOF
+	}
+	n := copy(b, u.data[u.off:])
+	u.off += n
+	return n, nil
+}
+
+func TestLimit(t *testing.T) {
+	calls := 0
+	r := readerFunc(func(b []byte) (int, error) {
+		calls++
+		return endless.Read(b)
+	})
+	if err := ValidateLimit(r, 10); err != nil {
+		t.Fatal(err)
+	}
+	// 0 + 1 + 2 + 3 + 5 = 11 bytes in five calls
+	if calls != 5 {
+		t.Errorf("ValidateLimit(10) made %d calls, want 5", calls)
+	}
+}
+
+func TestError(t *testing.T) {
+	e := &Error{[]Violation{{ZeroLengthRead, 1, 0, 3, nil}, {UnexpectedError, 2, 1, 0, io.ErrUnexpectedEOF}}}
+	want := "readercheck: 2 violation(s)\n" +
+		"\tread #1 (len 0): zero-length read returned data: n=3 err=<nil>\n" +
+		"\tread #2 (len 1): unexpected error: n=0 err=unexpected EOF"
+	if got := e.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if e.Has(NoProgress) {
+		t.Errorf("Has(NoProgress) = true")
+	}
+	if got := Rule(42).String(); got != "Rule(42)" {
+		t.Errorf("Rule(42) = %q", got)
+	}
+}
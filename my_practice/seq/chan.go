@@ -0,0 +1,52 @@
+package seq
+
+import "context"
+
+// Channels
+
+// Seq는 한 goroutine에서만 당겨야 한다. 다른 goroutine에 값을 넘기려면 ToChan으로
+// goroutine 하나가 Seq를 당겨서 channel로 보내게 한다.
+// 받는 쪽이 중간에 그만두면 보내는 goroutine이 channel에 막혀 영원히 남으므로(leak),
+// ctx를 cancel해서 멈추게 한다.
+
+// ToChan starts a goroutine that sends the values of s on the returned
+// channel and closes it when s ends or ctx is done, whichever is first.
+// A receiver that stops early must cancel ctx, or the goroutine blocks
+// forever on its next send. s must not be used by anything else afterwards.
+func ToChan[T any](ctx context.Context, s Seq[T]) <-chan T {
+	return ToChanBuffered(ctx, s, 0)
+}
+
+// ToChanBuffered is ToChan with a channel buffer of size n, so the
+// goroutine may pull up to n values ahead of the receiver.
+func ToChanBuffered[T any](ctx context.Context, s Seq[T], n int) <-chan T {
+	ch := make(chan T, n)
+	go func() {
+		defer close(ch)
+		for {
+			// 값을 당기기 전에도 확인해서, cancel 뒤에는 s를 더 계산하지 않는다
+			if ctx.Err() != nil {
+				return
+			}
+			v, ok := s()
+			if !ok {
+				return
+			}
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// FromChan returns a Seq of the values received from ch, ending when ch is
+// closed.
+func FromChan[T any](ch <-chan T) Seq[T] {
+	return func() (T, bool) {
+		v, ok := <-ch
+		return v, ok
+	}
+}
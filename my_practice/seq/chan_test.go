@@ -0,0 +1,83 @@
+package seq
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitGoroutines waits up to a second for the goroutine count to drop to
+// n, since senders take a moment to notice a cancel and return.
+func waitGoroutines(t *testing.T, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > n && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := runtime.NumGoroutine(); got > n {
+		t.Errorf("%d goroutines left running, want at most %d", got, n)
+	}
+}
+
+// 받는 쪽이 5개만 받고 cancel해도 보내는 goroutine은 channel을 닫고 끝나야 한다.
+func TestToChanCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChan(ctx, Primes())
+	var got []int
+	for p := range ch {
+		got = append(got, p)
+		if len(got) == 5 {
+			break
+		}
+	}
+	cancel()
+	for range ch {
+		// cancel과 동시에 보내진 값이 하나 더 있을 수 있다
+	}
+	if want := []int{2, 3, 5, 7, 11}; !slices.Equal(got, want) {
+		t.Errorf("first 5 = %v, want %v", got, want)
+	}
+	waitGoroutines(t, before)
+}
+
+func TestToChanCancelled(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if v, ok := <-ToChan(ctx, Repeat(1, -1)); ok {
+		t.Errorf("already cancelled: got %v", v)
+	}
+	waitGoroutines(t, before)
+}
+
+// 여러 goroutine이 하나의 Seq를 나눠 받는다.
+func TestFromChanWorkers(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := ToChanBuffered(ctx, Range(1, 1001, 1), 16)
+	var (
+		mu    sync.Mutex
+		total int
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sum := Reduce(FromChan(ch), 0, func(a, n int) int { return a + n*n })
+			mu.Lock()
+			total += sum
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if total != 333833500 {
+		t.Errorf("sum of squares 1..1000 = %d, want 333833500", total)
+	}
+	waitGoroutines(t, before)
+}
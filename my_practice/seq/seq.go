@@ -0,0 +1,312 @@
+// Package seq composes lazy sequences built from generator closures like
+// fibonacci() in utils/practice3.go.
+//
+// A Seq[T] is a function that returns the next value and true, or the zero
+// value and false once the sequence has ended. Nothing is computed until a
+// value is pulled, so infinite sequences such as Primes are fine as long as
+// something like Take or TakeWhile ends them:
+//
+//	seq.Collect(seq.Take(seq.Filter(seq.Fibonacci(), isEven), 5))
+//
+// A Seq has state, like the closures it generalizes: pulling a value
+// consumes it, and a Seq must not be pulled from two goroutines at once.
+// Use ToChan to hand values to other goroutines.
+package seq
+
+import (
+	"fmt"
+	"math"
+)
+
+// Seq is a lazy sequence. Each call returns the next value and true, or
+// the zero value and false when the sequence has ended; after that it
+// keeps returning false.
+type Seq[T any] func() (T, bool)
+
+// FromFunc turns an infinite generator such as fibonacci() into a Seq.
+func FromFunc[T any](next func() T) Seq[T] {
+	return func() (T, bool) { return next(), true }
+}
+
+// Of returns a Seq of the given values.
+func Of[T any](vs ...T) Seq[T] {
+	return FromSlice(vs)
+}
+
+// FromSlice returns a Seq of the elements of s. s is read lazily, so
+// changes made to it before an element is pulled are seen.
+func FromSlice[T any](s []T) Seq[T] {
+	i := 0
+	return func() (T, bool) {
+		if i >= len(s) {
+			var zero T
+			return zero, false
+		}
+		i++
+		return s[i-1], true
+	}
+}
+
+// Map returns a Seq of f(v) for every v of s.
+func Map[T, U any](s Seq[T], f func(T) U) Seq[U] {
+	return func() (U, bool) {
+		v, ok := s()
+		if !ok {
+			var zero U
+			return zero, false
+		}
+		return f(v), true
+	}
+}
+
+// Filter returns a Seq of the values of s for which keep returns true.
+func Filter[T any](s Seq[T], keep func(T) bool) Seq[T] {
+	return func() (T, bool) {
+		for {
+			v, ok := s()
+			if !ok || keep(v) {
+				return v, ok
+			}
+		}
+	}
+}
+
+// Take returns a Seq of at most the first n values of s. It stops pulling
+// from s after the nth value, so it can end an infinite sequence.
+func Take[T any](s Seq[T], n int) Seq[T] {
+	return func() (T, bool) {
+		if n <= 0 {
+			var zero T
+			return zero, false
+		}
+		n--
+		return s()
+	}
+}
+
+// TakeWhile returns a Seq of the values of s up to, but not including, the
+// first one for which ok returns false. That value is consumed from s.
+func TakeWhile[T any](s Seq[T], ok func(T) bool) Seq[T] {
+	done := false
+	return func() (T, bool) {
+		var zero T
+		if done {
+			return zero, false
+		}
+		v, more := s()
+		if !more || !ok(v) {
+			done = true
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Drop returns s without its first n values.
+func Drop[T any](s Seq[T], n int) Seq[T] {
+	return func() (T, bool) {
+		for ; n > 0; n-- {
+			if _, ok := s(); !ok {
+				var zero T
+				return zero, false
+			}
+		}
+		return s()
+	}
+}
+
+// Pair is a value from each of two sequences, as returned by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// Zip returns a Seq of pairs of the values of a and b, ending with the
+// shorter of the two.
+func Zip[A, B any](a Seq[A], b Seq[B]) Seq[Pair[A, B]] {
+	done := false
+	return func() (Pair[A, B], bool) {
+		if done {
+			return Pair[A, B]{}, false
+		}
+		x, ok1 := a()
+		y, ok2 := b()
+		if !ok1 || !ok2 {
+			done = true
+			return Pair[A, B]{}, false
+		}
+		return Pair[A, B]{x, y}, true
+	}
+}
+
+// Chain returns a Seq of the values of every s in turn.
+func Chain[T any](ss ...Seq[T]) Seq[T] {
+	return func() (T, bool) {
+		for len(ss) > 0 {
+			if v, ok := ss[0](); ok {
+				return v, true
+			}
+			ss = ss[1:]
+		}
+		var zero T
+		return zero, false
+	}
+}
+
+// Window returns a Seq of every run of n consecutive values of s, sliding
+// by one: Window(Of(1, 2, 3, 4), 2) yields [1 2], [2 3], [3 4]. Each
+// window is a new slice the caller may keep. If s has fewer than n values
+// the result is empty. Window panics if n < 1.
+func Window[T any](s Seq[T], n int) Seq[[]T] {
+	if n < 1 {
+		panic(fmt.Sprintf("seq: window size %d", n))
+	}
+	var last []T
+	return func() ([]T, bool) {
+		w := make([]T, 0, n)
+		if last == nil {
+			for len(w) < n {
+				v, ok := s()
+				if !ok {
+					return nil, false
+				}
+				w = append(w, v)
+			}
+		} else {
+			v, ok := s()
+			if !ok {
+				return nil, false
+			}
+			w = append(append(w, last[1:]...), v)
+		}
+		last = w
+		return w, true
+	}
+}
+
+// Scan returns a Seq of the running results of f, starting from init:
+// Scan(Of(1, 2, 3), 0, add) yields 1, 3, 6. It is adder() as a Seq.
+func Scan[T, A any](s Seq[T], init A, f func(A, T) A) Seq[A] {
+	acc := init
+	return func() (A, bool) {
+		v, ok := s()
+		if !ok {
+			var zero A
+			return zero, false
+		}
+		acc = f(acc, v)
+		return acc, true
+	}
+}
+
+// Reduce folds every value of s into init with f and returns the result.
+// It does not return if s is infinite.
+func Reduce[T, A any](s Seq[T], init A, f func(A, T) A) A {
+	acc := init
+	for v, ok := s(); ok; v, ok = s() {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Collect returns the values of s in a slice. It does not return if s is
+// infinite.
+func Collect[T any](s Seq[T]) []T {
+	return Reduce(s, []T(nil), func(list []T, v T) []T { return append(list, v) })
+}
+
+// Generators
+
+// Fibonacci returns the Fibonacci numbers 0, 1, 1, 2, 3, 5, ... It ends
+// with the largest one that fits in an int (F92 for a 64-bit int) rather
+// than overflowing.
+func Fibonacci() Seq[int] {
+	prev, cur := 0, 1
+	last, done := false, false
+	return func() (int, bool) {
+		if done {
+			return 0, false
+		}
+		res := prev
+		switch {
+		case last:
+			done = true
+		case cur > math.MaxInt-prev:
+			// 다음 값은 int를 넘는다. cur까지만 내보내고 끝낸다.
+			prev, last = cur, true
+		default:
+			prev, cur = cur, prev+cur
+		}
+		return res, true
+	}
+}
+
+// Primes returns the prime numbers 2, 3, 5, 7, ... using an incremental
+// sieve of Eratosthenes: each prime found so far is kept under its next
+// multiple, so no upper bound is needed.
+func Primes() Seq[int] {
+	next := make(map[int][]int) // 합성수 → 그 수를 다음 배수로 가진 소수들
+	n := 1
+	return func() (int, bool) {
+		for {
+			n++
+			primes, composite := next[n]
+			if !composite {
+				next[n*n] = []int{n}
+				return n, true
+			}
+			for _, p := range primes {
+				next[n+p] = append(next[n+p], p)
+			}
+			delete(next, n)
+		}
+	}
+}
+
+// Range returns start, start+step, ... up to but not including stop, like
+// Python's range. A negative step counts down. Range panics if step is 0.
+func Range(start, stop, step int) Seq[int] {
+	if step == 0 {
+		panic("seq: Range step is 0")
+	}
+	done := step > 0 && start >= stop || step < 0 && start <= stop
+	return func() (int, bool) {
+		if done {
+			return 0, false
+		}
+		v := start
+		// start+step는 math.MaxInt 근처에서 넘치므로 더하기 전에 stop까지 남은 거리와 비교한다.
+		// 거리는 int에 안 들어갈 수 있지만 uint에는 들어간다.
+		if step > 0 && uint(stop)-uint(start) <= uint(step) || step < 0 && uint(start)-uint(stop) <= -uint(step) {
+			done = true
+		} else {
+			start += step
+		}
+		return v, true
+	}
+}
+
+// Iterate returns the infinite Seq x, f(x), f(f(x)), ...
+func Iterate[T any](x T, f func(T) T) Seq[T] {
+	first := true
+	return func() (T, bool) {
+		if first {
+			first = false
+		} else {
+			x = f(x)
+		}
+		return x, true
+	}
+}
+
+// Repeat returns v n times, or forever if n < 0.
+func Repeat[T any](v T, n int) Seq[T] {
+	if n < 0 {
+		return func() (T, bool) { return v, true }
+	}
+	return Take(Repeat(v, -1), n)
+}
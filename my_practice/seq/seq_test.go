@@ -0,0 +1,122 @@
+package seq
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func add(a, b int) int { return a + b }
+
+func check[T comparable](t *testing.T, name string, s Seq[T], want ...T) {
+	t.Helper()
+	if got := Collect(s); !slices.Equal(got, want) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	check(t, "Fibonacci", Take(Fibonacci(), 15), 0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233, 377)
+	// int에 들어가는 피보나치 수는 93개이고, 그 뒤에서 멈춘다
+	if n := Reduce(Fibonacci(), 0, func(n, _ int) int { return n + 1 }); n != 93 {
+		t.Errorf("Fibonacci has %d values, want 93", n)
+	}
+	check(t, "last Fibonacci", Drop(Fibonacci(), 92), 7540113804746346429)
+	check(t, "Primes", Take(Primes(), 20), 2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71)
+	check(t, "1000th prime", Drop(Take(Primes(), 1000), 999), 7919)
+	check(t, "Iterate x*2", Take(Iterate(1, func(x int) int { return x * 2 }), 10), 1, 2, 4, 8, 16, 32, 64, 128, 256, 512)
+	check(t, "Repeat", Repeat("go", 3), "go", "go", "go")
+	check(t, "Repeat forever", Take(Repeat(7, -1), 2), 7, 7)
+
+	// fibonacci()와 같은 closure를 그대로 감쌀 수 있다
+	n := 0
+	check(t, "FromFunc", Take(FromFunc(func() int { n++; return n }), 3), 1, 2, 3)
+	check(t, "FromSlice", FromSlice([]int{4, 5}), 4, 5)
+}
+
+func TestRange(t *testing.T) {
+	const maxInt, minInt = math.MaxInt, math.MinInt
+	for _, c := range []struct {
+		start, stop, step int
+		want              []int
+	}{
+		{0, 10, 3, []int{0, 3, 6, 9}},
+		{10, 0, -3, []int{10, 7, 4, 1}},
+		{5, 5, 1, nil},
+		{5, 0, 1, nil},
+		// 끝 근처에서 overflow해서 처음으로 돌아가면 안 된다
+		{maxInt - 1, maxInt, 2, []int{maxInt - 1}},
+		{minInt + 1, minInt, -2, []int{minInt + 1}},
+		{minInt, maxInt, maxInt, []int{minInt, -1, maxInt - 1}},
+		{maxInt, minInt, minInt, []int{maxInt, -1}},
+	} {
+		name := fmt.Sprintf("Range(%d, %d, %d)", c.start, c.stop, c.step)
+		check(t, name, Take(Range(c.start, c.stop, c.step), 5), c.want...)
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	// Project Euler 2: 4,000,000 이하 짝수 피보나치 수의 합
+	if got := Reduce(Filter(TakeWhile(Fibonacci(), func(n int) bool { return n <= 4e6 }), even), 0, add); got != 4613732 {
+		t.Errorf("even Fibonacci sum = %d, want 4613732", got)
+	}
+	check(t, "Map", Map(Range(1, 6, 1), func(n int) string { return fmt.Sprint(n, "²=", n*n) }),
+		"1²=1", "2²=4", "3²=9", "4²=16", "5²=25")
+	check(t, "Zip", Zip(Of("a", "b", "c"), Primes()),
+		Pair[string, int]{"a", 2}, Pair[string, int]{"b", 3}, Pair[string, int]{"c", 5})
+	check(t, "Zip shorter right", Zip(Range(0, 100, 1), Of(true, false)),
+		Pair[int, bool]{0, true}, Pair[int, bool]{1, false})
+	check(t, "Chain", Chain(Of(1, 2), Of[int](), Range(10, 13, 1)), 1, 2, 10, 11, 12)
+	check(t, "Scan", Scan(Range(0, 10, 1), 0, add), 0, 1, 3, 6, 10, 15, 21, 28, 36, 45)
+	check(t, "Take 0", Take(Primes(), 0))
+	check(t, "TakeWhile none", TakeWhile(Of(1, 2), func(int) bool { return false }))
+	check(t, "Drop all", Drop(Of(1, 2), 5))
+
+	// 연속한 소수의 차이: Window로 두 개씩 묶는다
+	gaps := Map(Window(Primes(), 2), func(p []int) int { return p[1] - p[0] })
+	check(t, "prime gaps", Take(gaps, 15), 1, 2, 2, 4, 2, 4, 2, 4, 6, 2, 6, 4, 2, 4, 6)
+}
+
+func TestWindow(t *testing.T) {
+	got := Collect(Window(Range(1, 7, 1), 3))
+	want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Window 3 = %v, want %v", got, want)
+	}
+	// 돌려준 slice는 다음 window가 덮어쓰지 않는다
+	got[0][0] = 100
+	if got[1][0] != 2 {
+		t.Errorf("windows share memory: %v", got)
+	}
+	if got := Collect(Window(Of(1, 2), 3)); len(got) != 0 {
+		t.Errorf("Window too big = %v, want none", got)
+	}
+
+	defer func() {
+		if r := recover(); r != "seq: window size 0" {
+			t.Errorf("Window(0) panicked with %v", r)
+		}
+	}()
+	Window(Of(1), 0)
+	t.Error("Window(0) did not panic")
+}
+
+// Seq는 끝난 뒤에도 계속 false를 돌려준다.
+func TestAfterEnd(t *testing.T) {
+	for name, s := range map[string]Seq[int]{
+		"Take":      Take(Of(1, 2, 3), 2),
+		"Of":        Of(1),
+		"TakeWhile": TakeWhile(Of(1, 2), func(n int) bool { return n < 2 }),
+		"Range":     Range(0, 2, 1),
+	} {
+		Collect(s)
+		for i := 0; i < 3; i++ {
+			if v, ok := s(); ok {
+				t.Errorf("%s after end: %v, true", name, v)
+			}
+		}
+	}
+}
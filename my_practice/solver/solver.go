@@ -0,0 +1,401 @@
+// Package solver finds roots of f(x) = 0 for any func(float64) float64.
+//
+// It grows the Newton step of utils.ImprovedSqrt into four methods:
+//
+//	Newton    uses f' (given, or estimated by central differences)
+//	Secant    needs two starting points but no derivative
+//	Bisection needs a bracket [a, b] with a sign change; always converges
+//	Brent     needs a bracket too, and is usually as fast as Secant
+//
+// Each returns a Result with the root, the residual f(root) and the number
+// of iterations, plus an error if the method gave up.
+//
+// utils.Sqrt and the roots in utils share ErrInvalidOption with this
+// package, so errors.Is finds a bad option from either. Their Option,
+// WithMaxIter and ErrNoConvergence are separate types: utils' options
+// configure one fixed iteration, not a choice of methods, and its
+// ErrNoConvergence has the last guess rather than a whole Result.
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Method names a root-finding method.
+type Method int
+
+const (
+	Newton Method = iota
+	Secant
+	Bisection
+	Brent
+)
+
+var methodNames = [...]string{"Newton", "Secant", "Bisection", "Brent"}
+
+func (m Method) String() string {
+	if m < 0 || int(m) >= len(methodNames) {
+		return fmt.Sprintf("Method(%d)", int(m))
+	}
+	return methodNames[m]
+}
+
+// Result reports what a method found.
+type Result struct {
+	Method     Method
+	Root       float64
+	Residual   float64 // f(Root)
+	Iterations int
+	Converged  bool
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%v: root %v, f(root) %.3g, %d iterations", r.Method, r.Root, r.Residual, r.Iterations)
+}
+
+// ErrNotBracketed is returned by Bisection and Brent when f(A) and f(B)
+// have the same sign, so the interval is not known to contain a root.
+type ErrNotBracketed struct {
+	A, B, FA, FB float64
+}
+
+func (e *ErrNotBracketed) Error() string {
+	return fmt.Sprintf("root not bracketed: f(%v) = %v and f(%v) = %v have the same sign", e.A, e.FA, e.B, e.FB)
+}
+
+// ErrNoConvergence is returned with the last Result when MaxIter steps
+// did not meet the tolerance. It is not utils.ErrNoConvergence, which
+// has no Method or Residual to report.
+type ErrNoConvergence struct {
+	Result Result
+}
+
+func (e *ErrNoConvergence) Error() string {
+	return fmt.Sprintf("%v: no convergence after %d iterations (last x %v)", e.Result.Method, e.Result.Iterations, e.Result.Root)
+}
+
+var (
+	// ErrZeroDerivative is returned by Newton when f'(x) is 0 and by Secant
+	// when f(x0) = f(x1), since neither can take another step.
+	ErrZeroDerivative = errors.New("zero derivative")
+
+	// ErrNaN is returned when f returns NaN.
+	ErrNaN = errors.New("function returned NaN")
+
+	// ErrInvalidOption is returned when an Option is given a value it cannot
+	// use. utils.ErrInvalidOption is the same error.
+	ErrInvalidOption = errors.New("invalid option")
+)
+
+// Option configures a solver.
+type Option func(*options)
+
+type options struct {
+	xtol    float64
+	ftol    float64
+	maxIter int
+	deriv   func(float64) float64
+	err     error
+}
+
+func newOptions(opts []Option) (options, error) {
+	o := options{xtol: 1e-12, maxIter: 100}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o, o.err
+}
+
+// WithXTol stops once a step moves x by at most tol*max(1, |x|), or once a
+// bracket is that narrow. The default is 1e-12.
+func WithXTol(tol float64) Option {
+	return func(o *options) {
+		if !(tol >= 0) {
+			o.err = fmt.Errorf("%w: x tolerance %v", ErrInvalidOption, tol)
+			return
+		}
+		o.xtol = tol
+	}
+}
+
+// WithFTol also stops once |f(x)| <= tol. It is off (0) by default,
+// in which case only an exact zero stops on the residual.
+func WithFTol(tol float64) Option {
+	return func(o *options) {
+		if !(tol >= 0) {
+			o.err = fmt.Errorf("%w: f tolerance %v", ErrInvalidOption, tol)
+			return
+		}
+		o.ftol = tol
+	}
+}
+
+// WithMaxIter caps the number of iterations. The default is 100.
+func WithMaxIter(n int) Option {
+	return func(o *options) {
+		if n <= 0 {
+			o.err = fmt.Errorf("%w: max iterations %d", ErrInvalidOption, n)
+			return
+		}
+		o.maxIter = n
+	}
+}
+
+// WithDerivative gives Newton the derivative of f. Without it Newton uses
+// the central difference (f(x+h) - f(x-h)) / 2h.
+func WithDerivative(df func(float64) float64) Option {
+	return func(o *options) { o.deriv = df }
+}
+
+func (o *options) closeEnough(step, x float64) bool {
+	return math.Abs(step) <= o.xtol*math.Max(1, math.Abs(x))
+}
+
+func (o *options) smallResidual(fx float64) bool {
+	return math.Abs(fx) <= o.ftol
+}
+
+// Derivative estimates f'(x) with a central difference. The step
+// h = ∛ε * max(1, |x|) balances truncation against rounding error.
+func Derivative(f func(float64) float64, x float64) float64 {
+	h := 6.055454452393343e-06 * math.Max(1, math.Abs(x)) // ∛(2^-52)
+	return (f(x+h) - f(x-h)) / (2 * h)
+}
+
+// Solve dispatches to the method m. Newton and Secant use a as their
+// starting point (Secant also uses b); Bisection and Brent use [a, b].
+func Solve(m Method, f func(float64) float64, a, b float64, opts ...Option) (Result, error) {
+	switch m {
+	case Newton:
+		return NewtonRoot(f, a, opts...)
+	case Secant:
+		return SecantRoot(f, a, b, opts...)
+	case Bisection:
+		return BisectionRoot(f, a, b, opts...)
+	case Brent:
+		return BrentRoot(f, a, b, opts...)
+	}
+	return Result{}, fmt.Errorf("solver: unknown method %v", m)
+}
+
+// NewtonRoot iterates x -= f(x)/f'(x) from x0, the same update as
+// ImprovedSqrt with f(z) = z*z - x.
+func NewtonRoot(f func(float64) float64, x0 float64, opts ...Option) (Result, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return Result{}, err
+	}
+	df := o.deriv
+	if df == nil {
+		df = func(x float64) float64 { return Derivative(f, x) }
+	}
+
+	r := Result{Method: Newton, Root: x0}
+	x := x0
+	for r.Iterations < o.maxIter {
+		fx := f(x)
+		r.Root, r.Residual = x, fx
+		if math.IsNaN(fx) {
+			return r, ErrNaN
+		}
+		if fx == 0 || o.smallResidual(fx) {
+			r.Converged = true
+			return r, nil
+		}
+		d := df(x)
+		if d == 0 {
+			return r, ErrZeroDerivative
+		}
+		step := fx / d
+		x -= step
+		r.Iterations++
+		if o.closeEnough(step, x) {
+			r.Root, r.Residual, r.Converged = x, f(x), true
+			return r, nil
+		}
+	}
+	r.Root, r.Residual = x, f(x)
+	return r, &ErrNoConvergence{r}
+}
+
+// SecantRoot replaces f' in Newton's update with the slope through the
+// last two points, starting from x0 and x1.
+func SecantRoot(f func(float64) float64, x0, x1 float64, opts ...Option) (Result, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	r := Result{Method: Secant}
+	f0, f1 := f(x0), f(x1)
+	for {
+		r.Root, r.Residual = x1, f1
+		if math.IsNaN(f0) || math.IsNaN(f1) {
+			return r, ErrNaN
+		}
+		if f1 == 0 || o.smallResidual(f1) {
+			r.Converged = true
+			return r, nil
+		}
+		if r.Iterations == o.maxIter {
+			return r, &ErrNoConvergence{r}
+		}
+		if f1 == f0 {
+			return r, ErrZeroDerivative
+		}
+		step := f1 * (x1 - x0) / (f1 - f0)
+		x0, f0 = x1, f1
+		x1 -= step
+		f1 = f(x1)
+		r.Iterations++
+		if o.closeEnough(step, x1) {
+			r.Root, r.Residual, r.Converged = x1, f1, true
+			return r, nil
+		}
+	}
+}
+
+// bracket checks that [a, b] is a usable bracket and returns it ordered,
+// with f evaluated at both ends.
+func bracket(f func(float64) float64, a, b float64) (float64, float64, float64, float64, error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, 0, 0, 0, fmt.Errorf("solver: bracket [%v, %v] must be finite", a, b)
+	}
+	if a > b {
+		a, b = b, a
+	}
+	fa, fb := f(a), f(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return 0, 0, 0, 0, ErrNaN
+	}
+	if fa != 0 && fb != 0 && math.Signbit(fa) == math.Signbit(fb) {
+		return 0, 0, 0, 0, &ErrNotBracketed{a, b, fa, fb}
+	}
+	return a, b, fa, fb, nil
+}
+
+// BisectionRoot halves [a, b] keeping the half where f changes sign.
+func BisectionRoot(f func(float64) float64, a, b float64, opts ...Option) (Result, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return Result{}, err
+	}
+	a, b, fa, fb, err := bracket(f, a, b)
+	if err != nil {
+		return Result{Method: Bisection}, err
+	}
+	r := Result{Method: Bisection}
+	switch {
+	case fa == 0:
+		r.Root, r.Converged = a, true
+		return r, nil
+	case fb == 0:
+		r.Root, r.Converged = b, true
+		return r, nil
+	}
+
+	for r.Iterations < o.maxIter {
+		m := a + (b-a)/2
+		fm := f(m)
+		r.Iterations++
+		r.Root, r.Residual = m, fm
+		if math.IsNaN(fm) {
+			return r, ErrNaN
+		}
+		if fm == 0 || o.smallResidual(fm) || o.closeEnough(b-a, m) || m == a || m == b {
+			r.Converged = true
+			return r, nil
+		}
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+	return r, &ErrNoConvergence{r}
+}
+
+// BrentRoot is Brent's method: inverse quadratic interpolation or secant
+// steps when they stay inside the bracket and shrink it fast enough,
+// bisection otherwise. It follows the classic zeroin algorithm.
+func BrentRoot(f func(float64) float64, a, b float64, opts ...Option) (Result, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return Result{}, err
+	}
+	a, b, fa, fb, err := bracket(f, a, b)
+	if err != nil {
+		return Result{Method: Brent}, err
+	}
+	r := Result{Method: Brent}
+
+	// b는 지금까지의 최선, a는 이전 값, c는 b와 부호가 반대인 끝점
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for {
+		if (fb > 0 && fc > 0) || (fb < 0 && fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := o.xtol * math.Max(1, math.Abs(b)) / 2
+		m := (c - b) / 2
+		r.Root, r.Residual = b, fb
+		if math.Abs(m) <= tol || fb == 0 || o.smallResidual(fb) {
+			r.Converged = true
+			return r, nil
+		}
+		if r.Iterations == o.maxIter {
+			return r, &ErrNoConvergence{r}
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// secant
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// inverse quadratic interpolation
+				q0 := fa / fc
+				r0 := fb / fc
+				p = s * (2*m*q0*(q0-r0) - (b-a)*(r0-1))
+				q = (q0 - 1) * (r0 - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = m, m
+			}
+		} else {
+			d, e = m, m
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+		r.Iterations++
+		if math.IsNaN(fb) {
+			r.Root, r.Residual = b, fb
+			return r, ErrNaN
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package solver
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// practice3.go의 compute(fn)처럼 함수를 값으로 넘겨서 여러 방정식을 네 가지 방법으로 푼다.
+var problems = []struct {
+	name string
+	f    func(float64) float64
+	df   func(float64) float64
+	a, b float64
+	root float64
+}{
+	// ImprovedSqrt와 같은 문제: z*z - 2 = 0
+	{"z*z - 2", func(z float64) float64 { return z*z - 2 }, func(z float64) float64 { return 2 * z }, 1, 2, math.Sqrt2},
+	// Dottie number
+	{"cos(x) - x", func(x float64) float64 { return math.Cos(x) - x }, func(x float64) float64 { return -math.Sin(x) - 1 }, 0, 1, 0.7390851332151607},
+	// Wallis의 예제
+	{"x^3 - 2x - 5", func(x float64) float64 { return x*x*x - 2*x - 5 }, func(x float64) float64 { return 3*x*x - 2 }, 2, 3, 2.0945514815423265},
+	// compute(math.Pow)처럼 표준 라이브러리 함수를 그대로 넘긴다: 3^x = 4
+	{"pow(3, x) - 4", func(x float64) float64 { return math.Pow(3, x) - 4 }, nil, 1, 2, math.Log(4) / math.Log(3)},
+	{"exp(x) - 1e-8 (flat)", func(x float64) float64 { return math.Exp(x) - 1e-8 }, math.Exp, -20, 0, math.Log(1e-8)},
+}
+
+func TestSolve(t *testing.T) {
+	for _, p := range problems {
+		iters := map[Method]int{}
+		for _, m := range []Method{Newton, Secant, Bisection, Brent} {
+			var opts []Option
+			if m == Newton && p.df != nil {
+				opts = append(opts, WithDerivative(p.df))
+			}
+			r, err := Solve(m, p.f, p.a, p.b, opts...)
+			// 기본 x 허용오차 1e-12에 맞춰, 구간을 반씩 줄이는 Bisection도 통과할 만큼만 본다
+			if err != nil || !r.Converged || math.Abs(r.Root-p.root) > 1e-11*math.Max(1, math.Abs(p.root)) {
+				t.Errorf("%s: %v, %v; want root %v", p.name, r, err, p.root)
+			}
+			if r.Method != m || r.Residual != p.f(r.Root) || r.Iterations < 1 {
+				t.Errorf("%s: %v does not describe its own run", p.name, r)
+			}
+			iters[m] = r.Iterations
+		}
+		// Brent는 bracket을 쓰면서도 Bisection보다 훨씬 빨리 수렴한다
+		if iters[Brent] >= iters[Bisection] {
+			t.Errorf("%s: Brent took %d iterations, Bisection %d", p.name, iters[Brent], iters[Bisection])
+		}
+		// 도함수를 주지 않으면 central difference로 어림한다
+		if r, err := NewtonRoot(p.f, p.a); err != nil || math.Abs(r.Root-p.root) > 1e-11*math.Max(1, math.Abs(p.root)) {
+			t.Errorf("%s: Newton without f': %v, %v", p.name, r, err)
+		}
+	}
+	if _, err := Solve(Method(7), math.Sin, 3, 4); err == nil {
+		t.Error("Solve with an unknown method did not fail")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	square := func(x float64) float64 { return x*x + 1 }
+	_, err := BrentRoot(square, -1, 1)
+	var nb *ErrNotBracketed
+	if !errors.As(err, &nb) || *nb != (ErrNotBracketed{-1, 1, 2, 2}) {
+		t.Errorf("Brent x*x+1 on [-1, 1]: %v, want *ErrNotBracketed", err)
+	}
+	if _, err := BisectionRoot(math.Sqrt, -4, -1); !errors.Is(err, ErrNaN) {
+		t.Errorf("Bisection sqrt on [-4, -1]: %v, want ErrNaN", err)
+	}
+	if _, err := NewtonRoot(square, 0); !errors.Is(err, ErrZeroDerivative) {
+		t.Errorf("Newton x*x+1 from 0: %v, want ErrZeroDerivative", err)
+	}
+	if _, err := SecantRoot(func(float64) float64 { return 1 }, 0, 1); !errors.Is(err, ErrZeroDerivative) {
+		t.Errorf("Secant on a constant: %v, want ErrZeroDerivative", err)
+	}
+
+	// cbrt(x)에서 Newton은 매번 -2x로 튀어 나간다
+	r, err := NewtonRoot(math.Cbrt, 1, WithMaxIter(20))
+	var nc *ErrNoConvergence
+	if !errors.As(err, &nc) || nc.Result != r || r.Iterations != 20 || r.Converged {
+		t.Errorf("Newton cbrt(x) from 1: %v, %v; want *ErrNoConvergence after 20", r, err)
+	}
+
+	for name, opt := range map[string]Option{
+		"WithXTol(-1)":   WithXTol(-1),
+		"WithXTol(NaN)":  WithXTol(math.NaN()),
+		"WithFTol(-1)":   WithFTol(-1),
+		"WithMaxIter(0)": WithMaxIter(0),
+	} {
+		if _, err := BrentRoot(math.Sin, 3, 4, opt); !errors.Is(err, ErrInvalidOption) {
+			t.Errorf("%s: %v, want ErrInvalidOption", name, err)
+		}
+	}
+}
+
+func TestFTol(t *testing.T) {
+	// 구간의 끝은 어느 쪽을 먼저 줘도 된다
+	exact, err := BrentRoot(math.Sin, 4, 3)
+	if err != nil || math.Abs(exact.Root-math.Pi) > 1e-12 {
+		t.Errorf("Brent sin on [4, 3]: %v, %v", exact, err)
+	}
+	loose, err := BrentRoot(math.Sin, 4, 3, WithFTol(1e-3))
+	if err != nil || math.Abs(loose.Residual) > 1e-3 || loose.Iterations > exact.Iterations {
+		t.Errorf("Brent sin on [4, 3] with WithFTol(1e-3): %v, %v", loose, err)
+	}
+}
+
+func TestMethodString(t *testing.T) {
+	for m, want := range map[Method]string{Newton: "Newton", Brent: "Brent", Method(9): "Method(9)"} {
+		if got := m.String(); got != want {
+			t.Errorf("Method(%d).String() = %q, want %q", int(m), got, want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package stats
+
+// Closures
+
+// adder()처럼 값을 넣으면 지금까지의 결과를 돌려주는 closure.
+// 안에 있는 accumulator는 closure 밖에서 보이지 않으므로 Merge할 수 없다.
+// Merge가 필요하면 struct를 직접 쓴다.
+
+// Running returns a closure that records x and returns the summary of
+// every value recorded so far.
+func Running() func(x float64) Summary {
+	var s Stats
+	return func(x float64) Summary {
+		s.Add(x)
+		return s.Summary()
+	}
+}
+
+// Averager returns a closure that records x and returns the running mean.
+func Averager() func(x float64) float64 {
+	var m Moments
+	return func(x float64) float64 {
+		m.Add(x)
+		return m.Mean()
+	}
+}
+
+// Smoother returns a closure that records x and returns the exponential
+// moving average with the given alpha. See NewEMA.
+func Smoother(alpha float64) func(x float64) float64 {
+	e := NewEMA(alpha)
+	return func(x float64) float64 {
+		e.Add(x)
+		return e.Value()
+	}
+}
+
+// Quantiler returns a closure that records x and returns an estimate of
+// the q-quantile so far, using a Digest with DefaultCompression. It is
+// slower than using a Digest directly, because every call has to merge the
+// values buffered by the digest to answer.
+func Quantiler(q float64) func(x float64) float64 {
+	d := NewDigest(DefaultCompression)
+	return func(x float64) float64 {
+		d.Add(x)
+		return d.Quantile(q)
+	}
+}
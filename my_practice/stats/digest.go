@@ -0,0 +1,176 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Digest estimates quantiles such as the median or the 99th percentile
+// from a stream, using a t-digest (Dunning, "Computing extremely accurate
+// quantiles using t-digests", 2019).
+//
+// A t-digest keeps the values as a sorted list of centroids, each a mean
+// and a count. Centroids near the middle of the distribution may hold many
+// values; near the ends they stay small, so extreme quantiles stay
+// accurate. Memory is bounded by the compression, not by the number of
+// values, and two digests merge by combining their centroids.
+type Digest struct {
+	compression float64
+	centroids   []centroid // mean 순으로 정렬되어 있다
+	buf         []centroid // 아직 합치지 않은 값
+	n           float64    // buf까지 포함한 개수
+	min, max    float64
+}
+
+type centroid struct {
+	mean, count float64
+}
+
+// DefaultCompression gives errors of about 0.1% of rank or less in the
+// middle and much less near the ends, with at most a few hundred
+// centroids.
+const DefaultCompression = 100
+
+// NewDigest returns an empty Digest. A larger compression keeps more
+// centroids and is more accurate; it panics if compression < 10.
+func NewDigest(compression float64) *Digest {
+	if !(compression >= 10) {
+		panic(fmt.Sprintf("stats: digest compression %v < 10", compression))
+	}
+	return &Digest{compression: compression}
+}
+
+// Add records x.
+func (d *Digest) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	if d.n == 0 || x < d.min {
+		d.min = x
+	}
+	if d.n == 0 || x > d.max {
+		d.max = x
+	}
+	d.n++
+	d.buf = append(d.buf, centroid{x, 1})
+	if len(d.buf) >= d.bufSize() {
+		d.compress()
+	}
+}
+
+func (d *Digest) bufSize() int { return int(5 * d.compression) }
+
+// Merge adds the values recorded by o to d. o is not changed.
+func (d *Digest) Merge(o *Digest) {
+	if o.n == 0 {
+		return
+	}
+	if d.n == 0 || o.min < d.min {
+		d.min = o.min
+	}
+	if d.n == 0 || o.max > d.max {
+		d.max = o.max
+	}
+	d.n += o.n
+	d.buf = append(d.buf, o.centroids...)
+	d.buf = append(d.buf, o.buf...)
+	d.compress()
+}
+
+// compress merges buf into centroids. Going through all centroids in
+// order, it joins neighbours as long as the joined centroid stays within
+// one unit of the scale function k.
+func (d *Digest) compress() {
+	if len(d.buf) == 0 {
+		return
+	}
+	all := append(d.centroids, d.buf...)
+	d.buf = d.buf[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	out := all[:1] // 제자리에서 합친다. out은 all보다 앞서 가지 않는다
+	before := 0.0  // out의 마지막 centroid 앞에 있는 값의 개수
+	limit := d.n * d.kInverse(d.k(0)+1)
+	for _, c := range all[1:] {
+		last := &out[len(out)-1]
+		if before+last.count+c.count <= limit {
+			last.count += c.count
+			last.mean += (c.mean - last.mean) * c.count / last.count
+			continue
+		}
+		before += last.count
+		limit = d.n * d.kInverse(d.k(before/d.n)+1)
+		out = append(out, c)
+	}
+	d.centroids = out
+}
+
+// k is the scale function k₁(q) = δ/2π · asin(2q-1). Its slope is large
+// near q = 0 and q = 1, which keeps centroids there small.
+func (d *Digest) k(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (d *Digest) kInverse(k float64) float64 {
+	if k >= d.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/d.compression) + 1) / 2
+}
+
+// Count returns the number of values recorded.
+func (d *Digest) Count() int { return int(d.n) }
+
+// Quantile returns an estimate of the q-quantile, the value below which a
+// fraction q of the values lie: Quantile(0.5) is the median. Quantile(0)
+// and Quantile(1) are the exact minimum and maximum. It returns NaN if
+// nothing was recorded or q is not in [0, 1].
+func (d *Digest) Quantile(q float64) float64 {
+	if d.n == 0 || !(q >= 0 && q <= 1) {
+		return math.NaN()
+	}
+	d.compress()
+	cs := d.centroids
+	target := q * d.n
+	// 각 centroid의 값들은 그 mean을 가운데로 고르게 퍼져 있다고 보고
+	// 가운데 사이를 선형으로 잇는다. 양 끝은 min과 max에 잇는다.
+	first, last := cs[0], cs[len(cs)-1]
+	if target <= first.count/2 {
+		return lerp(d.min, first.mean, target/(first.count/2))
+	}
+	if target >= d.n-last.count/2 {
+		return lerp(last.mean, d.max, (target-(d.n-last.count/2))/(last.count/2))
+	}
+	mid := first.count / 2 // 현재 centroid의 가운데까지 개수
+	for i := 1; i < len(cs); i++ {
+		next := mid + cs[i-1].count/2 + cs[i].count/2
+		if target <= next {
+			return lerp(cs[i-1].mean, cs[i].mean, (target-mid)/(next-mid))
+		}
+		mid = next
+	}
+	return d.max
+}
+
+// Median returns Quantile(0.5).
+func (d *Digest) Median() float64 { return d.Quantile(0.5) }
+
+// Centroids returns the number of centroids the digest keeps, a measure
+// of its size.
+func (d *Digest) Centroids() int {
+	d.compress()
+	return len(d.centroids)
+}
+
+func lerp(a, b, t float64) float64 {
+	if math.IsNaN(t) || math.IsInf(t, 0) {
+		return a
+	}
+	return a + (b-a)*t
+}
+
+func (d *Digest) String() string {
+	return fmt.Sprintf("n=%d p50=%.6g p90=%.6g p99=%.6g", d.Count(),
+		d.Quantile(0.5), d.Quantile(0.9), d.Quantile(0.99))
+}
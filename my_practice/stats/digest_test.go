@@ -0,0 +1,86 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+// Digest의 quantile은 정렬해서 구한 값과 rank로 비교한다:
+// 추정값보다 작은 값의 비율이 q와 얼마나 다른지를 본다.
+func TestDigest(t *testing.T) {
+	qs := []float64{0, 0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999, 1}
+	for _, name := range []string{"uniform", "normal", "exponential", "sorted"} {
+		xs := testData(name, 100000)
+		d := NewDigest(DefaultCompression)
+		for _, x := range xs {
+			d.Add(x)
+		}
+		sort.Float64s(xs)
+		if d.Count() != len(xs) {
+			t.Errorf("%s: Count = %d, want %d", name, d.Count(), len(xs))
+		}
+		if d.Quantile(0) != xs[0] || d.Quantile(1) != xs[len(xs)-1] {
+			t.Errorf("%s: min %g max %g, want %g %g", name, d.Quantile(0), d.Quantile(1), xs[0], xs[len(xs)-1])
+		}
+		if c := d.Centroids(); c > 2*DefaultCompression {
+			t.Errorf("%s: %d centroids, want at most %d", name, c, 2*DefaultCompression)
+		}
+		for _, q := range qs {
+			est := d.Quantile(q)
+			rank := float64(sort.SearchFloat64s(xs, est)) / float64(len(xs))
+			if e := math.Abs(rank - q); e > 0.002 {
+				t.Errorf("%s: Quantile(%g) = %g has rank %g, error %.4f", name, q, est, rank, e)
+			}
+		}
+	}
+}
+
+func TestDigestSmall(t *testing.T) {
+	d := NewDigest(DefaultCompression)
+	if !math.IsNaN(d.Median()) || d.String() != "n=0 p50=NaN p90=NaN p99=NaN" {
+		t.Errorf("empty digest = %v", d)
+	}
+	for _, x := range []float64{5, 1, 3} {
+		d.Add(x)
+	}
+	for _, c := range []struct{ q, want float64 }{
+		{0, 1},
+		{0.5, 3},
+		{1, 5},
+		{-1, math.NaN()},
+		{2, math.NaN()},
+	} {
+		if got := d.Quantile(c.q); got != c.want && !(math.IsNaN(got) && math.IsNaN(c.want)) {
+			t.Errorf("Quantile(%g) = %g, want %g", c.q, got, c.want)
+		}
+	}
+}
+
+func TestDigestMerge(t *testing.T) {
+	xs := testData("normal", 100000)
+	one := NewDigest(DefaultCompression)
+	for _, x := range xs {
+		one.Add(x)
+	}
+	par := Parallel(func() *Digest { return NewDigest(DefaultCompression) }, xs[:1], xs[1:30000], xs[30000:])
+	if par.Count() != one.Count() {
+		t.Errorf("Parallel Count = %d, want %d", par.Count(), one.Count())
+	}
+	// 합친 digest는 centroid가 달라지므로 rank error 안에서만 같다
+	sort.Float64s(xs)
+	rank := float64(sort.SearchFloat64s(xs, par.Median())) / float64(len(xs))
+	if math.Abs(rank-0.5) > 0.002 {
+		t.Errorf("Parallel Median = %g has rank %g (one digest %g)", par.Median(), rank, one.Median())
+	}
+}
+
+func TestDigestPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "stats: digest compression 1 < 10" {
+			t.Errorf("NewDigest(1) panicked with %v", r)
+		}
+	}()
+	NewDigest(1)
+	t.Error("NewDigest(1) did not panic")
+}
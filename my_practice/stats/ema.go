@@ -0,0 +1,75 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+)
+
+// EMA is an exponential moving average: each value added moves the average
+// a fraction alpha of the way towards it, so older values count less and
+// less.
+//
+// It starts from 0 and divides by 1 - (1-alpha)^n to correct for that
+// start (bias correction), so the first value added is the average rather
+// than alpha times it. This also makes EMAs mergeable: the average of a
+// stream split in two pieces is
+//
+//	e = (1-alpha)^n₂ e₁ + e₂
+//
+// before correction, where e₂ and n₂ belong to the later piece.
+type EMA struct {
+	alpha float64
+	e     float64 // 보정 전 값
+	decay float64 // (1-alpha)^n
+	n     int
+}
+
+// NewEMA returns an EMA with the given smoothing factor. It panics unless
+// 0 < alpha <= 1. An alpha of 2/(N+1) is roughly an average of the last N
+// values.
+func NewEMA(alpha float64) *EMA {
+	if !(alpha > 0 && alpha <= 1) {
+		panic(fmt.Sprintf("stats: EMA alpha %v not in (0, 1]", alpha))
+	}
+	return &EMA{alpha: alpha, decay: 1}
+}
+
+// Add records x.
+func (e *EMA) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	e.e += e.alpha * (x - e.e)
+	e.decay *= 1 - e.alpha
+	e.n++
+}
+
+// Merge adds the values recorded by o to e as if they came after every
+// value recorded by e. Unlike the other accumulators the order matters:
+// a.Merge(b) and b.Merge(a) differ. It panics if the two alphas differ.
+func (e *EMA) Merge(o *EMA) {
+	if e.alpha != o.alpha {
+		panic(fmt.Sprintf("stats: merging EMAs with alpha %v and %v", e.alpha, o.alpha))
+	}
+	e.e = e.e*o.decay + o.e
+	e.decay *= o.decay
+	e.n += o.n
+}
+
+// Count returns the number of values recorded.
+func (e *EMA) Count() int { return e.n }
+
+// Alpha returns the smoothing factor.
+func (e *EMA) Alpha() float64 { return e.alpha }
+
+// Value returns the average, or NaN if nothing was recorded.
+func (e *EMA) Value() float64 {
+	if e.n == 0 {
+		return math.NaN()
+	}
+	return e.e / (1 - e.decay)
+}
+
+func (e *EMA) String() string {
+	return fmt.Sprintf("n=%d ema(%g)=%.6g", e.n, e.alpha, e.Value())
+}
@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEMA(t *testing.T) {
+	e := NewEMA(0.1)
+	if e.Count() != 0 || !math.IsNaN(e.Value()) || e.String() != "n=0 ema(0.1)=NaN" {
+		t.Errorf("empty EMA = %v", e)
+	}
+
+	// 먼저 넣은 값일수록 가중치가 작으므로 Merge는 순서에 따라 결과가 다르다
+	a, b := NewEMA(0.5), NewEMA(0.5)
+	a.Add(1)
+	b.Add(9)
+	ab, ba := *a, *b
+	ab.Merge(b)
+	ba.Merge(a)
+	if !near(ab.Value(), 19.0/3) || !near(ba.Value(), 11.0/3) {
+		t.Errorf("1 then 9 = %g, 9 then 1 = %g, want %g, %g", ab.Value(), ba.Value(), 19.0/3, 11.0/3)
+	}
+
+	xs := testData("normal", 100000)
+	one := NewEMA(0.01)
+	for _, x := range xs {
+		one.Add(x)
+	}
+	par := Parallel(func() *EMA { return NewEMA(0.01) }, xs[:1], xs[1:30000], xs[30000:])
+	if par.Count() != one.Count() || !near(par.Value(), one.Value()) {
+		t.Errorf("Parallel = %v, one EMA %v", par, one)
+	}
+}
+
+func TestEMAPanics(t *testing.T) {
+	for _, c := range []struct {
+		f    func()
+		want string
+	}{
+		{func() { NewEMA(0) }, "stats: EMA alpha 0 not in (0, 1]"},
+		{func() { NewEMA(1.5) }, "stats: EMA alpha 1.5 not in (0, 1]"},
+		{func() { NewEMA(math.NaN()) }, "stats: EMA alpha NaN not in (0, 1]"},
+		{func() { NewEMA(0.1).Merge(NewEMA(0.2)) }, "stats: merging EMAs with alpha 0.1 and 0.2"},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != c.want {
+					t.Errorf("panic %v, want %q", r, c.want)
+				}
+			}()
+			c.f()
+		}()
+	}
+}
@@ -0,0 +1,259 @@
+// Package stats keeps running statistics over a stream of float64 values
+// without storing the values, the way adder() in utils/practice3.go keeps
+// a running sum.
+//
+// Each accumulator is a struct whose zero value is ready to use, except
+// EMA and Digest, which need a parameter and have constructors:
+//
+//	Moments  count, sum, mean and variance (Welford's algorithm)
+//	MinMax   minimum and maximum
+//	Stats    Moments and MinMax together, reported as a Summary
+//	EMA      exponential moving average
+//	Digest   approximate quantiles (t-digest)
+//
+// Closures such as Running and Averager in closure.go wrap them for code
+// written in the adder() style.
+//
+// An accumulator is not safe for concurrent use. To use several
+// goroutines, give each its own accumulator and Merge them at the end;
+// Parallel does exactly that. Add ignores NaN.
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Accumulator is what every accumulator in this package implements.
+type Accumulator interface {
+	// Add records x. NaN is ignored.
+	Add(x float64)
+	// Count returns the number of values recorded.
+	Count() int
+	fmt.Stringer
+}
+
+// Mergeable is an accumulator that can absorb another of the same type, as
+// if every value added to the other had been added to it as well.
+type Mergeable[T any] interface {
+	Accumulator
+	Merge(other T)
+}
+
+// Parallel adds each part in its own goroutine to an accumulator made by
+// newAcc, then merges the results in the order of parts and returns it.
+// Order matters only to EMA, for which parts should be consecutive pieces
+// of one stream.
+func Parallel[T Mergeable[T]](newAcc func() T, parts ...[]float64) T {
+	accs := make([]T, len(parts))
+	var wg sync.WaitGroup
+	for i, part := range parts {
+		accs[i] = newAcc()
+		wg.Add(1)
+		go func(acc T, part []float64) {
+			defer wg.Done()
+			for _, x := range part {
+				acc.Add(x)
+			}
+		}(accs[i], part)
+	}
+	wg.Wait()
+	if len(accs) == 0 {
+		return newAcc()
+	}
+	for _, acc := range accs[1:] {
+		accs[0].Merge(acc)
+	}
+	return accs[0]
+}
+
+// Moments keeps the count, sum, mean and variance of the values added.
+//
+// The variance uses Welford's algorithm, which updates the mean and the
+// sum of squared differences from it one value at a time. Keeping Σx and
+// Σx² instead and computing Σx²/n - mean² loses all precision when the
+// values are large and close together.
+type Moments struct {
+	n    int
+	sum  float64
+	mean float64
+	m2   float64 // Σ(x - mean)²
+}
+
+// Add records x.
+func (m *Moments) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	m.n++
+	m.sum += x
+	d := x - m.mean
+	m.mean += d / float64(m.n)
+	m.m2 += d * (x - m.mean)
+}
+
+// Merge adds the values recorded by o to m, using Chan et al.'s formula
+// for combining two variances.
+func (m *Moments) Merge(o *Moments) {
+	if o.n == 0 {
+		return
+	}
+	if m.n == 0 {
+		*m = *o
+		return
+	}
+	n := m.n + o.n
+	d := o.mean - m.mean
+	m.mean += d * float64(o.n) / float64(n)
+	m.m2 += o.m2 + d*d*float64(m.n)*float64(o.n)/float64(n)
+	m.sum += o.sum
+	m.n = n
+}
+
+// Count returns the number of values recorded.
+func (m *Moments) Count() int { return m.n }
+
+// Sum returns the sum of the values recorded.
+func (m *Moments) Sum() float64 { return m.sum }
+
+// Mean returns the mean, or NaN if nothing was recorded.
+func (m *Moments) Mean() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.mean
+}
+
+// Variance returns the population variance Σ(x - mean)² / n, or NaN if
+// nothing was recorded.
+func (m *Moments) Variance() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.n)
+}
+
+// SampleVariance returns the sample variance Σ(x - mean)² / (n-1), or NaN
+// if fewer than two values were recorded.
+func (m *Moments) SampleVariance() float64 {
+	if m.n < 2 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.n-1)
+}
+
+// StdDev returns the population standard deviation.
+func (m *Moments) StdDev() float64 { return math.Sqrt(m.Variance()) }
+
+func (m *Moments) String() string {
+	return fmt.Sprintf("n=%d mean=%.6g sd=%.6g", m.n, m.Mean(), m.StdDev())
+}
+
+// MinMax keeps the smallest and largest values added.
+type MinMax struct {
+	n        int
+	min, max float64
+}
+
+// Add records x.
+func (m *MinMax) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	if m.n == 0 || x < m.min {
+		m.min = x
+	}
+	if m.n == 0 || x > m.max {
+		m.max = x
+	}
+	m.n++
+}
+
+// Merge adds the values recorded by o to m.
+func (m *MinMax) Merge(o *MinMax) {
+	if o.n == 0 {
+		return
+	}
+	if m.n == 0 || o.min < m.min {
+		m.min = o.min
+	}
+	if m.n == 0 || o.max > m.max {
+		m.max = o.max
+	}
+	m.n += o.n
+}
+
+// Count returns the number of values recorded.
+func (m *MinMax) Count() int { return m.n }
+
+// Min returns the smallest value, or NaN if nothing was recorded.
+func (m *MinMax) Min() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.min
+}
+
+// Max returns the largest value, or NaN if nothing was recorded.
+func (m *MinMax) Max() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.max
+}
+
+func (m *MinMax) String() string {
+	return fmt.Sprintf("n=%d min=%.6g max=%.6g", m.n, m.Min(), m.Max())
+}
+
+// Stats keeps Moments and MinMax together.
+type Stats struct {
+	moments Moments
+	minMax  MinMax
+}
+
+// Add records x.
+func (s *Stats) Add(x float64) {
+	s.moments.Add(x)
+	s.minMax.Add(x)
+}
+
+// Merge adds the values recorded by o to s.
+func (s *Stats) Merge(o *Stats) {
+	s.moments.Merge(&o.moments)
+	s.minMax.Merge(&o.minMax)
+}
+
+// Count returns the number of values recorded.
+func (s *Stats) Count() int { return s.moments.Count() }
+
+// Summary returns the statistics recorded so far.
+func (s *Stats) Summary() Summary {
+	return Summary{
+		Count:    s.moments.Count(),
+		Sum:      s.moments.Sum(),
+		Mean:     s.moments.Mean(),
+		Variance: s.moments.Variance(),
+		StdDev:   s.moments.StdDev(),
+		Min:      s.minMax.Min(),
+		Max:      s.minMax.Max(),
+	}
+}
+
+func (s *Stats) String() string { return s.Summary().String() }
+
+// Summary is a snapshot of a Stats. Variance and StdDev are the
+// population ones; Mean, Variance, StdDev, Min and Max are NaN when Count
+// is 0.
+type Summary struct {
+	Count            int
+	Sum, Mean        float64
+	Variance, StdDev float64
+	Min, Max         float64
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("n=%d sum=%g mean=%.6g sd=%.6g min=%g max=%g",
+		s.Count, s.Sum, s.Mean, s.StdDev, s.Min, s.Max)
+}
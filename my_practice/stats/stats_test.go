@@ -0,0 +1,140 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// near reports whether a and b agree to about nine significant digits.
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(a))
+}
+
+// testData returns n values from a few distributions, always the same
+// for the same name.
+func testData(name string, n int) []float64 {
+	r := rand.New(rand.NewSource(1))
+	xs := make([]float64, n)
+	for i := range xs {
+		switch name {
+		case "uniform":
+			xs[i] = r.Float64()
+		case "normal":
+			xs[i] = r.NormFloat64()*10 + 100
+		case "exponential":
+			xs[i] = r.ExpFloat64()
+		case "sorted":
+			xs[i] = float64(i)
+		}
+	}
+	return xs
+}
+
+func TestStats(t *testing.T) {
+	var s Stats
+	for _, x := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		s.Add(x)
+	}
+	want := Summary{Count: 8, Sum: 40, Mean: 5, Variance: 4, StdDev: 2, Min: 2, Max: 9}
+	if got := s.Summary(); got != want {
+		t.Errorf("Summary = %+v, want %+v", got, want)
+	}
+	if got := s.String(); got != "n=8 sum=40 mean=5 sd=2 min=2 max=9" {
+		t.Errorf("String = %q", got)
+	}
+	var m Moments
+	for _, x := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		m.Add(x)
+	}
+	if got := m.SampleVariance(); !near(got, 32.0/7) {
+		t.Errorf("SampleVariance = %g, want %g", got, 32.0/7)
+	}
+}
+
+// 빈 Stats는 NaN을 돌려주고, NaN은 세지 않는다.
+func TestStatsEmptyAndNaN(t *testing.T) {
+	var s Stats
+	got := s.Summary()
+	if got.Count != 0 || got.Sum != 0 || !math.IsNaN(got.Mean) || !math.IsNaN(got.StdDev) ||
+		!math.IsNaN(got.Min) || !math.IsNaN(got.Max) {
+		t.Errorf("empty Summary = %+v", got)
+	}
+	s.Add(math.NaN())
+	s.Add(3)
+	s.Add(math.NaN())
+	want := Summary{Count: 1, Sum: 3, Mean: 3, Min: 3, Max: 3}
+	if got := s.Summary(); got != want {
+		t.Errorf("Summary with NaN = %+v, want %+v", got, want)
+	}
+}
+
+// Σx²/n - mean²는 큰 값에서 자릿수를 모두 잃는다. 4, 7, 13, 16의 분산은 22.5.
+func TestMomentsPrecision(t *testing.T) {
+	var m Moments
+	for _, x := range []float64{4, 7, 13, 16} {
+		m.Add(x + 1e9)
+	}
+	if got := m.Variance(); got != 22.5 {
+		t.Errorf("Variance = %g, want 22.5", got)
+	}
+}
+
+// 나눠서 Parallel로 합친 결과는 한 번에 넣은 결과와 같아야 한다.
+func TestParallel(t *testing.T) {
+	xs := testData("normal", 100000)
+	parts := [][]float64{xs[:1], xs[1:30000], xs[30000:30000], xs[30000:]}
+
+	var all Stats
+	for _, x := range xs {
+		all.Add(x)
+	}
+	par := Parallel(func() *Stats { return new(Stats) }, parts...).Summary()
+	seq := all.Summary()
+	if par.Count != seq.Count || !near(par.Sum, seq.Sum) || !near(par.Mean, seq.Mean) ||
+		!near(par.Variance, seq.Variance) || par.Min != seq.Min || par.Max != seq.Max {
+		t.Errorf("Parallel = %+v, serial %+v", par, seq)
+	}
+
+	none := Parallel(func() *Moments { return new(Moments) })
+	if none.Count() != 0 || !math.IsNaN(none.Mean()) {
+		t.Errorf("Parallel with no parts = %v", none)
+	}
+}
+
+func TestClosures(t *testing.T) {
+	pos, neg := Running(), Running()
+	var got Summary
+	for i := 0; i < 5; i++ {
+		got = pos(float64(i))
+		neg(float64(-2 * i))
+	}
+	want := Summary{Count: 5, Sum: 10, Mean: 2, Variance: 2, StdDev: math.Sqrt2, Min: 0, Max: 4}
+	if got != want {
+		t.Errorf("Running after 0..4 = %+v, want %+v", got, want)
+	}
+	if got := neg(-10); got.Count != 6 || got.Sum != -30 || got.Min != -10 || got.Max != 0 {
+		t.Errorf("Running after 0, -2, ..., -10 = %+v", got)
+	}
+
+	avg, ema := Averager(), Smoother(0.5)
+	for _, c := range []struct{ x, mean, ema float64 }{
+		{1, 1, 1},
+		{2, 1.5, 5.0 / 3},
+		{3, 2, 17.0 / 7},
+		{10, 4, 97.0 / 15},
+	} {
+		if m, e := avg(c.x), ema(c.x); m != c.mean || !near(e, c.ema) {
+			t.Errorf("add %g: mean %g ema %g, want %g %g", c.x, m, e, c.mean, c.ema)
+		}
+	}
+
+	q := Quantiler(0.5)
+	var med float64
+	for _, x := range []float64{5, 1, 3} {
+		med = q(x)
+	}
+	if med != 3 {
+		t.Errorf("Quantiler(0.5) of 5, 1, 3 = %g, want 3", med)
+	}
+}
@@ -0,0 +1,356 @@
+package tictactoe
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// AI
+
+// minimax: 내 차례에는 가장 좋은 수를, 상대 차례에는 나에게 가장 나쁜 수를 고른다고 보고
+// 끝까지 내려가서 점수를 매긴다. 두 사람 점수의 합이 0이므로 늘 "둘 차례인 사람" 쪽에서
+// 점수를 매기고 한 단계 올라갈 때마다 부호만 바꾼다(negamax).
+// alpha-beta: 이미 찾은 수보다 나빠질 게 확실한 가지는 더 보지 않는다.
+// 같은 판은 여러 순서로 만들어지므로 결과를 판마다 기억해 둔다(transposition table).
+
+const (
+	// Win is the score of a won game. A win in d more moves scores
+	// Win - d, so the AI prefers quick wins and slow losses.
+	Win = 1_000_000
+	// winBound separates won and lost scores from heuristic ones.
+	winBound = Win - 10_000
+	infinity = Win + 1
+)
+
+// ErrInvalidOption is returned by NewAI when an Option is given a value it
+// cannot use.
+var ErrInvalidOption = errors.New("tictactoe: invalid option")
+
+// Option configures an AI.
+type Option func(*AI)
+
+// WithDepth limits the search to depth moves ahead, after which positions
+// are scored by a heuristic: the open lines each player could still
+// complete, longer ones counting much more. 0, the default, searches every
+// game to the end, which gives perfect play but is only fast enough for
+// boards of up to about 16 cells. A depth-limited search also only
+// considers cells next to a mark already on the board.
+func WithDepth(depth int) Option {
+	return func(ai *AI) {
+		if depth < 0 {
+			ai.err = fmt.Errorf("%w: depth %d", ErrInvalidOption, depth)
+			return
+		}
+		ai.depth = depth
+	}
+}
+
+// WithRand makes the AI choose at random among equally good moves. By
+// default it always plays the same move in the same position: the one
+// nearest the centre.
+func WithRand(r *rand.Rand) Option {
+	return func(ai *AI) { ai.rng = r }
+}
+
+// AI chooses moves by searching the game tree. It remembers positions it
+// has searched, so it gets faster over a game, and should be used for one
+// board size at a time.
+type AI struct {
+	depth int
+	rng   *rand.Rand
+	err   error
+
+	n, k  int
+	table map[string]entry
+	key   []byte
+	nodes int
+}
+
+// bound tells how a stored score relates to the true one: alpha-beta may
+// stop early and only learn that it is at least or at most that much.
+type bound int8
+
+const (
+	exact bound = iota
+	lower
+	upper
+)
+
+type entry struct {
+	score int
+	depth int
+	bound bound
+	best  Move
+}
+
+// NewAI returns an AI. Without options it plays perfectly.
+func NewAI(opts ...Option) (*AI, error) {
+	ai := &AI{}
+	for _, opt := range opts {
+		opt(ai)
+	}
+	if ai.err != nil {
+		return nil, ai.err
+	}
+	return ai, nil
+}
+
+// Result is a move chosen by the AI and how the game goes from there.
+type Result struct {
+	Move Move
+	// Score is from the point of view of the player to move: Win - d if
+	// they win in d moves, -(Win - d) if they lose, 0 for a draw, and a
+	// heuristic score in between when the search was cut off.
+	Score int
+	// Nodes is the number of positions searched.
+	Nodes int
+}
+
+// String describes the result, "b2 (wins in 3)".
+func (r Result) String() string {
+	return fmt.Sprintf("%v (%s)", r.Move, Outcome(r.Score))
+}
+
+// Outcome describes a score: "wins in 3", "loses in 2", "draw" or, for a
+// heuristic score, "score +12".
+func Outcome(score int) string {
+	switch {
+	case score > winBound:
+		return fmt.Sprintf("wins in %d", Win-score)
+	case score < -winBound:
+		return fmt.Sprintf("loses in %d", Win+score)
+	case score == 0:
+		return "draw"
+	}
+	return fmt.Sprintf("score %+d", score)
+}
+
+// Best returns the AI's move for the player to move on b. b is left as it
+// was. It returns an error wrapping ErrGameOver if the game is over.
+func (ai *AI) Best(b *Board) (Result, error) {
+	if b.state != InProgress {
+		return Result{}, fmt.Errorf("tictactoe: no move for %v: %w", b.Turn(), ErrGameOver)
+	}
+	if ai.table == nil || ai.n != b.n || ai.k != b.k || ai.depth > 0 {
+		// 다른 판의 결과는 쓸 수 없다. 깊이를 제한하면 점수가 어림값이라 매번 새로 찾는다.
+		ai.table = make(map[string]entry)
+		ai.n, ai.k = b.n, b.k
+	}
+	ai.nodes = 0
+	b = b.Clone()
+	depth := ai.depth
+	if depth == 0 || depth > len(b.cells)-len(b.history) {
+		depth = len(b.cells) - len(b.history)
+	}
+
+	moves := ai.order(b, Move{-1, -1})
+	if ai.rng != nil {
+		// 같은 점수인 수를 모두 알아야 그중에서 고를 수 있으므로 root에서는 가지를 치지 않는다
+		ai.rng.Shuffle(len(moves), func(i, j int) { moves[i], moves[j] = moves[j], moves[i] })
+		var best []Move
+		bestScore := -infinity
+		for _, m := range moves {
+			b.play(m)
+			s := -ai.search(b, depth-1, 1, -infinity, infinity)
+			b.Undo()
+			if s > bestScore {
+				best, bestScore = best[:0], s
+			}
+			if s == bestScore {
+				best = append(best, m)
+			}
+		}
+		return Result{best[ai.rng.Intn(len(best))], bestScore, ai.nodes}, nil
+	}
+	best, score := moves[0], -infinity
+	alpha := -infinity
+	for _, m := range moves {
+		b.play(m)
+		s := -ai.search(b, depth-1, 1, -infinity, -alpha)
+		b.Undo()
+		if s > score {
+			best, score = m, s
+		}
+		if s > alpha {
+			alpha = s
+		}
+	}
+	return Result{best, score, ai.nodes}, nil
+}
+
+// search returns the score of b for the player to move, looking depth
+// moves ahead. ply is the number of moves since the root, so that a win
+// found sooner scores higher.
+func (ai *AI) search(b *Board, depth, ply, alpha, beta int) int {
+	ai.nodes++
+	switch b.state {
+	case Draw:
+		return 0
+	case XWins, OWins:
+		// 방금 둔 사람이 이겼으므로 둘 차례인 사람은 졌다
+		return -(Win - ply)
+	}
+	if depth == 0 {
+		return ai.heuristic(b)
+	}
+
+	key := ai.keyOf(b)
+	e, seen := ai.table[key]
+	ttMove := Move{-1, -1}
+	if seen {
+		ttMove = e.best
+		if e.depth >= depth {
+			s := fromTable(e.score, ply)
+			switch {
+			case e.bound == exact,
+				e.bound == lower && s >= beta,
+				e.bound == upper && s <= alpha:
+				return s
+			}
+		}
+	}
+
+	alpha0 := alpha
+	best, bestMove := -infinity, ttMove
+	for _, m := range ai.order(b, ttMove) {
+		b.play(m)
+		s := -ai.search(b, depth-1, ply+1, -beta, -alpha)
+		b.Undo()
+		if s > best {
+			best, bestMove = s, m
+		}
+		if s > alpha {
+			alpha = s
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	e = entry{toTable(best, ply), depth, exact, bestMove}
+	switch {
+	case best <= alpha0:
+		e.bound = upper
+	case best >= beta:
+		e.bound = lower
+	}
+	ai.table[key] = e
+	return best
+}
+
+// Scores in the table are counted from the position, not from the root,
+// because the same position is reached at different plies.
+func toTable(score, ply int) int {
+	switch {
+	case score > winBound:
+		return score + ply
+	case score < -winBound:
+		return score - ply
+	}
+	return score
+}
+
+func fromTable(score, ply int) int {
+	switch {
+	case score > winBound:
+		return score - ply
+	case score < -winBound:
+		return score + ply
+	}
+	return score
+}
+
+func (ai *AI) keyOf(b *Board) string {
+	ai.key = ai.key[:0]
+	for _, p := range b.cells {
+		ai.key = append(ai.key, byte(p))
+	}
+	return string(ai.key)
+}
+
+// order returns the moves to try, first the best move found before in this
+// position and then the others nearest the centre first; good moves first
+// let alpha-beta cut more.
+func (ai *AI) order(b *Board, first Move) []Move {
+	var moves []Move
+	if ai.depth > 0 && len(b.history) > 0 {
+		moves = b.nearMarks()
+	} else {
+		moves = b.Legal()
+	}
+	centre := b.n - 1 // 좌표를 두 배로 해서 정수로 계산한다
+	dist := func(m Move) int {
+		dr, dc := 2*m.Row-centre, 2*m.Col-centre
+		return dr*dr + dc*dc
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		if (moves[i] == first) != (moves[j] == first) {
+			return moves[i] == first
+		}
+		return dist(moves[i]) < dist(moves[j])
+	})
+	return moves
+}
+
+// nearMarks returns the empty cells next to a mark, in row-major order.
+func (b *Board) nearMarks() []Move {
+	var moves []Move
+	for i, p := range b.cells {
+		if p != Empty {
+			continue
+		}
+		r, c := i/b.n, i%b.n
+	near:
+		for dr := -1; dr <= 1; dr++ {
+			for dc := -1; dc <= 1; dc++ {
+				if b.At(r+dr, c+dc) != Empty {
+					moves = append(moves, Move{r, c})
+					break near
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// heuristic scores b for the player to move by the lines of K cells that
+// only one player has marks in: such a line can still be completed, and
+// more marks in it bring that closer. A line with c marks counts 4^c.
+func (ai *AI) heuristic(b *Board) int {
+	me := b.Turn()
+	score := 0
+	for _, d := range directions {
+		for r := 0; r < b.n; r++ {
+			for c := 0; c < b.n; c++ {
+				end := Move{r + (b.k-1)*d[0], c + (b.k-1)*d[1]}
+				if !b.inside(end.Row, end.Col) {
+					continue
+				}
+				mine, theirs := 0, 0
+				for i := 0; i < b.k; i++ {
+					switch b.cells[(r+i*d[0])*b.n+c+i*d[1]] {
+					case me:
+						mine++
+					case me.Other():
+						theirs++
+					}
+				}
+				switch {
+				case theirs == 0 && mine > 0:
+					score += 1 << (2 * mine)
+				case mine == 0 && theirs > 0:
+					score -= 1 << (2 * theirs)
+				}
+			}
+		}
+	}
+	// 어림값이 이긴 점수와 섞이지 않게 한다
+	if score > winBound/2 {
+		score = winBound / 2
+	} else if score < -winBound/2 {
+		score = -winBound / 2
+	}
+	return score
+}
@@ -0,0 +1,178 @@
+package tictactoe
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func newAI(t *testing.T, opts ...Option) *AI {
+	t.Helper()
+	ai, err := NewAI(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ai
+}
+
+func TestBest(t *testing.T) {
+	ai := newAI(t)
+	for _, c := range []struct {
+		moves string
+		want  string
+	}{
+		// 이길 수 있으면 가장 빨리 이기고, 질 수밖에 없으면 가장 늦게 진다
+		{"a1 b1 b2 c1", "c3 (wins in 1)"},
+		{"a1 b1 b2", "c3 (loses in 4)"},
+		{"a1 b2 c3 b1", "b3 (draw)"},
+		{"b2 a1 c1 a3 a2", "c2 (draw)"},
+		{"a1 c3 c2 a2 c1", "b1 (draw)"},
+	} {
+		b := newBoard(t, 3, 3, c.moves)
+		r, err := ai.Best(b)
+		if err != nil || r.String() != c.want {
+			t.Errorf("%s: Best = %v, %v, want %s", c.moves, r, err, c.want)
+		}
+		if len(b.History()) != len(strings.Fields(c.moves)) {
+			t.Errorf("%s: Best changed the board", c.moves)
+		}
+	}
+
+	// 첫 수는 어디에 두어도 비긴다
+	b := newBoard(t, 3, 3, "")
+	for _, m := range b.Legal() {
+		b.Play(m)
+		if r, _ := ai.Best(b); r.Score != 0 {
+			t.Errorf("X opening %v: %s, want draw", m, Outcome(-r.Score))
+		}
+		b.Undo()
+	}
+
+	_, err := ai.Best(newBoard(t, 3, 3, "a1 a2 b1 b2 c1"))
+	if !errors.Is(err, ErrGameOver) || err.Error() != "tictactoe: no move for O: game is over" {
+		t.Errorf("Best after the end: %v", err)
+	}
+}
+
+func TestNewAI(t *testing.T) {
+	_, err := NewAI(WithDepth(-1))
+	if !errors.Is(err, ErrInvalidOption) || err.Error() != "tictactoe: invalid option: depth -1" {
+		t.Errorf("WithDepth(-1): err = %v", err)
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	for _, c := range []struct {
+		score int
+		want  string
+	}{
+		{Win - 3, "wins in 3"},
+		{-(Win - 2), "loses in 2"},
+		{0, "draw"},
+		{12, "score +12"},
+		{-7, "score -7"},
+	} {
+		if got := Outcome(c.score); got != c.want {
+			t.Errorf("Outcome(%d) = %q, want %q", c.score, got, c.want)
+		}
+	}
+}
+
+// AI가 한쪽을 맡고 상대는 모든 수를 두어 보아도 AI는 지지 않는다.
+func TestPerfectPlay(t *testing.T) {
+	for _, c := range []struct {
+		side             Player
+		games, won, drew int
+	}{
+		{X, 76, 72, 4},
+		{O, 457, 366, 91},
+	} {
+		ai := newAI(t)
+		b := newBoard(t, 3, 3, "")
+		results := map[State]int{}
+		var walk func()
+		walk = func() {
+			if b.State() != InProgress {
+				results[b.State()]++
+				return
+			}
+			if b.Turn() == c.side {
+				r, _ := ai.Best(b)
+				b.Play(r.Move)
+				walk()
+				b.Undo()
+				return
+			}
+			for _, m := range b.Legal() {
+				b.Play(m)
+				walk()
+				b.Undo()
+			}
+		}
+		walk()
+		games := results[XWins] + results[OWins] + results[Draw]
+		if lost := results[winState(c.side.Other())]; lost != 0 {
+			t.Errorf("AI as %v lost %d games", c.side, lost)
+		}
+		if games != c.games || results[winState(c.side)] != c.won || results[Draw] != c.drew {
+			t.Errorf("AI as %v: %d games, won %d, drew %d; want %d, %d, %d",
+				c.side, games, results[winState(c.side)], results[Draw], c.games, c.won, c.drew)
+		}
+	}
+}
+
+// AI끼리 두게 한다. 깊이 제한이 없으면 결과는 게임 이론으로 알려진 값이다.
+func TestSelfPlay(t *testing.T) {
+	for _, c := range []struct {
+		n, k, depth int
+		want        State
+		moves       int
+	}{
+		{3, 3, 0, Draw, 9},
+		{4, 3, 0, XWins, 5},
+		{4, 4, 0, Draw, 16},
+		{7, 4, 3, XWins, 9},
+		{9, 5, 2, Draw, 81},
+	} {
+		if testing.Short() && c.n > 4 {
+			continue
+		}
+		b := newBoard(t, c.n, c.k, "")
+		ai := newAI(t, WithDepth(c.depth))
+		for b.State() == InProgress {
+			r, err := ai.Best(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.Play(r.Move)
+		}
+		if b.State() != c.want || len(b.History()) != c.moves {
+			t.Errorf("%d×%d, %d in a row, depth %d: %v in %d moves, want %v in %d\n%s",
+				c.n, c.n, c.k, c.depth, b.State(), len(b.History()), c.want, c.moves, b.Labeled())
+		}
+	}
+}
+
+// 난수를 주면 같은 점수인 수 중에서 고르지만 결과는 여전히 비긴다.
+func TestRandomAI(t *testing.T) {
+	ai := newAI(t, WithRand(rand.New(rand.NewSource(1))))
+	openings := map[Move]bool{}
+	for i := 0; i < 20; i++ {
+		b := newBoard(t, 3, 3, "")
+		for b.State() == InProgress {
+			r, _ := ai.Best(b)
+			if len(b.History()) == 0 {
+				openings[r.Move] = true
+			}
+			b.Play(r.Move)
+		}
+		if b.State() != Draw {
+			t.Errorf("game %d: %v, want draw: %v", i, b.State(), fmt.Sprint(b.History()))
+		}
+	}
+	if len(openings) < 2 {
+		t.Errorf("%d different openings in 20 games, want several", len(openings))
+	}
+}
@@ -0,0 +1,338 @@
+// Package tictactoe plays tic-tac-toe, grown from the [][]string board of
+// utils.Practice3_14: a typed Board that checks every move and knows when
+// the game is over, and an AI that searches the game tree with minimax and
+// alpha-beta pruning.
+//
+// The board may be any N×N with K in a row to win (K <= N), from the usual
+// 3×3 with 3 to gomoku-like 15×15 with 5. On small boards the AI searches
+// to the end and never loses; on large ones it needs a depth limit and
+// falls back to a heuristic (see WithDepth).
+//
+// Moves are written like chess squares: a column letter and a row number
+// counted from the top, so "a1" is the top-left corner and "c3" the
+// bottom-right one of a 3×3 board.
+package tictactoe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Player is the mark in a cell: Empty, X or O. X always moves first.
+type Player int8
+
+const (
+	Empty Player = iota
+	X
+	O
+)
+
+// String returns "_", "X" or "O", as on the board of Practice3_14.
+func (p Player) String() string {
+	switch p {
+	case Empty:
+		return "_"
+	case X:
+		return "X"
+	case O:
+		return "O"
+	}
+	return fmt.Sprintf("Player(%d)", int(p))
+}
+
+// Other returns the opponent of X or O.
+func (p Player) Other() Player { return 3 - p }
+
+// State is the state of a game.
+type State int
+
+const (
+	InProgress State = iota
+	XWins
+	OWins
+	Draw
+)
+
+var stateNames = [...]string{"in progress", "X wins", "O wins", "draw"}
+
+func (s State) String() string {
+	if s < 0 || int(s) >= len(stateNames) {
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+	return stateNames[s]
+}
+
+func winState(p Player) State {
+	if p == X {
+		return XWins
+	}
+	return OWins
+}
+
+// Move is a cell, counted from 0 at the top-left corner.
+type Move struct {
+	Row, Col int
+}
+
+// String returns the move as a column letter and a 1-based row, "b2".
+// Boards are at most 26 columns wide, so one letter is enough.
+func (m Move) String() string {
+	if m.Col < 0 || m.Col >= 26 || m.Row < 0 {
+		return fmt.Sprintf("(%d,%d)", m.Row, m.Col)
+	}
+	return fmt.Sprintf("%c%d", 'a'+m.Col, m.Row+1)
+}
+
+// ParseMove parses a move written as by Move.String, such as "b2" or
+// "B2". It does not check that the move fits a board; Play does.
+func ParseMove(s string) (Move, error) {
+	t := strings.ToLower(strings.TrimSpace(s))
+	var m Move
+	if len(t) < 2 || t[0] < 'a' || t[0] > 'z' {
+		return m, fmt.Errorf("%w %q: want a column letter and a row number, like b2", ErrSyntax, s)
+	}
+	m.Col = int(t[0] - 'a')
+	for _, c := range t[1:] {
+		if c < '0' || c > '9' || m.Row > 1000 {
+			return m, fmt.Errorf("%w %q: want a column letter and a row number, like b2", ErrSyntax, s)
+		}
+		m.Row = m.Row*10 + int(c-'0')
+	}
+	if m.Row == 0 {
+		return m, fmt.Errorf("%w %q: rows start at 1", ErrSyntax, s)
+	}
+	m.Row--
+	return m, nil
+}
+
+// MaxSize is the largest board, limited by the column letters a–z.
+const MaxSize = 26
+
+var (
+	// ErrSize is returned by New for a board it cannot make.
+	ErrSize = errors.New("tictactoe: invalid board size")
+
+	// ErrSyntax is returned by ParseMove.
+	ErrSyntax = errors.New("tictactoe: invalid move")
+
+	// ErrTag is returned for a record tag that cannot be written, such as
+	// one named Size, which the text format keeps for the board.
+	ErrTag = errors.New("tictactoe: invalid tag")
+
+	// ErrOffBoard, ErrOccupied and ErrGameOver are the reasons a MoveError
+	// gives for rejecting a move.
+	ErrOffBoard = errors.New("off the board")
+	ErrOccupied = errors.New("cell is taken")
+	ErrGameOver = errors.New("game is over")
+)
+
+// MoveError is returned by Play for a move that is not allowed. Err is
+// ErrOffBoard, ErrOccupied or ErrGameOver.
+type MoveError struct {
+	Player Player
+	Move   Move
+	Err    error
+}
+
+func (e *MoveError) Error() string {
+	return fmt.Sprintf("tictactoe: %v cannot play %v: %v", e.Player, e.Move, e.Err)
+}
+
+func (e *MoveError) Unwrap() error { return e.Err }
+
+// Board is an N×N tic-tac-toe game with K in a row to win. It records the
+// moves played, so they can be taken back with Undo.
+type Board struct {
+	n, k    int
+	cells   []Player // row-major
+	history []Move
+	state   State
+}
+
+// New returns an empty n×n board on which k in a row wins. It returns an
+// error wrapping ErrSize unless 1 <= k <= n <= MaxSize.
+func New(n, k int) (*Board, error) {
+	if n < 1 || n > MaxSize || k < 1 || k > n {
+		return nil, fmt.Errorf("%w: %d×%d with %d in a row", ErrSize, n, n, k)
+	}
+	return &Board{n: n, k: k, cells: make([]Player, n*n)}, nil
+}
+
+// Size returns N.
+func (b *Board) Size() int { return b.n }
+
+// K returns the number in a row needed to win.
+func (b *Board) K() int { return b.k }
+
+// At returns the mark at row r, column c, or Empty outside the board.
+func (b *Board) At(r, c int) Player {
+	if !b.inside(r, c) {
+		return Empty
+	}
+	return b.cells[r*b.n+c]
+}
+
+func (b *Board) inside(r, c int) bool {
+	return r >= 0 && r < b.n && c >= 0 && c < b.n
+}
+
+// Turn returns the player to move. It is X or O even when the game is
+// over.
+func (b *Board) Turn() Player {
+	if len(b.history)%2 == 0 {
+		return X
+	}
+	return O
+}
+
+// State returns whether the game is over and how it ended.
+func (b *Board) State() State { return b.state }
+
+// Winner returns the winner, or Empty if the game is not won.
+func (b *Board) Winner() Player {
+	switch b.state {
+	case XWins:
+		return X
+	case OWins:
+		return O
+	}
+	return Empty
+}
+
+// History returns the moves played so far, in order.
+func (b *Board) History() []Move {
+	return append([]Move(nil), b.history...)
+}
+
+// Play puts the mark of the player to move on m. It returns a *MoveError
+// if the move is off the board, the cell is taken or the game is over.
+func (b *Board) Play(m Move) error {
+	var err error
+	switch {
+	case b.state != InProgress:
+		err = ErrGameOver
+	case !b.inside(m.Row, m.Col):
+		err = ErrOffBoard
+	case b.cells[m.Row*b.n+m.Col] != Empty:
+		err = ErrOccupied
+	}
+	if err != nil {
+		return &MoveError{b.Turn(), m, err}
+	}
+	b.play(m)
+	return nil
+}
+
+// play is Play without the checks, for the search.
+func (b *Board) play(m Move) {
+	p := b.Turn()
+	b.cells[m.Row*b.n+m.Col] = p
+	b.history = append(b.history, m)
+	switch {
+	case b.wins(m, p):
+		b.state = winState(p)
+	case len(b.history) == len(b.cells):
+		b.state = Draw
+	}
+}
+
+// Undo takes back the last move. It returns false if no move was played.
+func (b *Board) Undo() bool {
+	if len(b.history) == 0 {
+		return false
+	}
+	m := b.history[len(b.history)-1]
+	b.history = b.history[:len(b.history)-1]
+	b.cells[m.Row*b.n+m.Col] = Empty
+	// 끝난 뒤에는 둘 수 없으므로, 마지막 수 전에는 항상 진행 중이었다
+	b.state = InProgress
+	return true
+}
+
+// directions are the four lines through a cell: across, down and the two
+// diagonals.
+var directions = [4][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+// wins reports whether p has k in a row through m. Only lines through the
+// last move can be new, so the whole board is never scanned.
+func (b *Board) wins(m Move, p Player) bool {
+	for _, d := range directions {
+		if 1+b.run(m, d[0], d[1], p)+b.run(m, -d[0], -d[1], p) >= b.k {
+			return true
+		}
+	}
+	return false
+}
+
+// run counts the marks of p next to m in direction (dr, dc).
+func (b *Board) run(m Move, dr, dc int, p Player) int {
+	n := 0
+	for r, c := m.Row+dr, m.Col+dc; b.At(r, c) == p; r, c = r+dr, c+dc {
+		n++
+	}
+	return n
+}
+
+// Legal returns the empty cells, in row-major order, or nil if the game is
+// over.
+func (b *Board) Legal() []Move {
+	if b.state != InProgress {
+		return nil
+	}
+	moves := make([]Move, 0, len(b.cells)-len(b.history))
+	for i, p := range b.cells {
+		if p == Empty {
+			moves = append(moves, Move{i / b.n, i % b.n})
+		}
+	}
+	return moves
+}
+
+// Clone returns an independent copy of b.
+func (b *Board) Clone() *Board {
+	c := *b
+	c.cells = append([]Player(nil), b.cells...)
+	c.history = append([]Move(nil), b.history...)
+	return &c
+}
+
+// Rows returns the board as rows of "_", "X" and "O", the [][]string of
+// Practice3_14.
+func (b *Board) Rows() [][]string {
+	rows := make([][]string, b.n)
+	for r := range rows {
+		rows[r] = make([]string, b.n)
+		for c := range rows[r] {
+			rows[r][c] = b.cells[r*b.n+c].String()
+		}
+	}
+	return rows
+}
+
+// String returns the board one row per line, the cells separated by
+// spaces, as Practice3_14 prints it.
+func (b *Board) String() string {
+	var s strings.Builder
+	for _, row := range b.Rows() {
+		s.WriteString(strings.Join(row, " "))
+		s.WriteByte('\n')
+	}
+	return s.String()
+}
+
+// Labeled returns String with column letters above and row numbers on the
+// left, for a player who has to type moves.
+func (b *Board) Labeled() string {
+	var s strings.Builder
+	width := len(fmt.Sprint(b.n))
+	fmt.Fprintf(&s, "%*s", width, "")
+	for c := 0; c < b.n; c++ {
+		fmt.Fprintf(&s, " %c", 'a'+c)
+	}
+	s.WriteByte('\n')
+	for r, row := range b.Rows() {
+		fmt.Fprintf(&s, "%*d %s\n", width, r+1, strings.Join(row, " "))
+	}
+	return s.String()
+}
@@ -0,0 +1,192 @@
+package tictactoe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newBoard returns an n×n board, k in a row, after the given moves.
+func newBoard(t *testing.T, n, k int, moves string) *Board {
+	t.Helper()
+	b, err := New(n, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range strings.Fields(moves) {
+		m, err := ParseMove(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Play(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestBoard(t *testing.T) {
+	b := newBoard(t, 3, 3, "a1 c3 c2 a2 c1")
+	if got, want := b.String(), "X _ X\nO _ X\n_ _ O\n"; got != want {
+		t.Errorf("String =\n%swant\n%s", got, want)
+	}
+	if b.State() != InProgress || b.Turn() != O || b.Winner() != Empty {
+		t.Errorf("state %v, turn %v, winner %v", b.State(), b.Turn(), b.Winner())
+	}
+	if got := fmt.Sprint(b.Legal()); got != "[b1 b2 a3 b3]" {
+		t.Errorf("Legal = %s, want [b1 b2 a3 b3]", got)
+	}
+	if got := fmt.Sprint(b.History()); got != "[a1 c3 c2 a2 c1]" {
+		t.Errorf("History = %s", got)
+	}
+
+	for _, s := range strings.Fields("b1 b2 a3 b3") {
+		m, _ := ParseMove(s)
+		if err := b.Play(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := b.Labeled(), "  a b c\n1 X O X\n2 O X X\n3 O X O\n"; got != want {
+		t.Errorf("Labeled =\n%swant\n%s", got, want)
+	}
+	if b.State() != Draw || b.Winner() != Empty {
+		t.Errorf("state %v, winner %v, want draw", b.State(), b.Winner())
+	}
+
+	c := b.Clone()
+	if !b.Undo() || b.State() != InProgress || len(b.History()) != 8 {
+		t.Errorf("Undo: state %v, %d moves", b.State(), len(b.History()))
+	}
+	if c.State() != Draw || len(c.History()) != 9 {
+		t.Error("Undo changed the clone")
+	}
+	if newBoard(t, 3, 3, "").Undo() {
+		t.Error("Undo on an empty board returned true")
+	}
+}
+
+func TestPlayErrors(t *testing.T) {
+	b := newBoard(t, 3, 3, "a1 c3 c2 a2 c1 b1 b2 a3 b3")
+	err := b.Play(Move{2, 1})
+	var me *MoveError
+	if !errors.As(err, &me) || *me != (MoveError{O, Move{2, 1}, ErrGameOver}) {
+		t.Errorf("play after the end: %v", err)
+	}
+	if err.Error() != "tictactoe: O cannot play b3: game is over" {
+		t.Errorf("Error() = %q", err)
+	}
+
+	b.Undo()
+	for _, c := range []struct {
+		move string
+		want error
+	}{
+		{"a1", ErrOccupied},
+		{"d1", ErrOffBoard},
+		{"b4", ErrOffBoard},
+	} {
+		m, _ := ParseMove(c.move)
+		if err := b.Play(m); !errors.Is(err, c.want) {
+			t.Errorf("Play(%s) = %v, want %v", c.move, err, c.want)
+		}
+	}
+	if len(b.History()) != 8 {
+		t.Errorf("failed moves were recorded: %v", b.History())
+	}
+}
+
+func TestParseMove(t *testing.T) {
+	for _, c := range []struct {
+		s    string
+		want Move
+	}{
+		{"a1", Move{0, 0}},
+		{"b2", Move{1, 1}},
+		{"B2", Move{1, 1}},
+		{" c10 ", Move{9, 2}},
+		{"z26", Move{25, 25}},
+	} {
+		if got, err := ParseMove(c.s); got != c.want || err != nil {
+			t.Errorf("ParseMove(%q) = %v, %v, want %v", c.s, got, err, c.want)
+		}
+	}
+	for _, c := range []struct{ s, msg string }{
+		{"", "want a column letter and a row number, like b2"},
+		{"b", "want a column letter and a row number, like b2"},
+		{"11", "want a column letter and a row number, like b2"},
+		{"b0", "rows start at 1"},
+		{"bb", "want a column letter and a row number, like b2"},
+		{"b-1", "want a column letter and a row number, like b2"},
+	} {
+		_, err := ParseMove(c.s)
+		want := fmt.Sprintf("tictactoe: invalid move %q: %s", c.s, c.msg)
+		if !errors.Is(err, ErrSyntax) || err.Error() != want {
+			t.Errorf("ParseMove(%q): err = %v, want %s", c.s, err, want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, nk := range [][2]int{{0, 0}, {3, 4}, {27, 5}, {3, 0}} {
+		_, err := New(nk[0], nk[1])
+		want := fmt.Sprintf("tictactoe: invalid board size: %d×%d with %d in a row", nk[0], nk[0], nk[1])
+		if !errors.Is(err, ErrSize) || err.Error() != want {
+			t.Errorf("New(%d, %d): err = %v, want %s", nk[0], nk[1], err, want)
+		}
+	}
+	for _, nk := range [][2]int{{1, 1}, {3, 3}, {MaxSize, 5}} {
+		if b, err := New(nk[0], nk[1]); err != nil || b.Size() != nk[0] || b.K() != nk[1] {
+			t.Errorf("New(%d, %d) = %v, %v", nk[0], nk[1], b, err)
+		}
+	}
+}
+
+func TestLines(t *testing.T) {
+	for _, c := range []struct {
+		n, k  int
+		moves string
+		want  State
+	}{
+		{3, 3, "a1 a2 b1 b2 c1", XWins},            // row
+		{3, 3, "b1 a1 b2 a2 c3 a3", OWins},         // column, O
+		{3, 3, "a1 b1 b2 c1 c3", XWins},            // diagonal
+		{3, 3, "c1 a1 b2 a2 a3", XWins},            // anti-diagonal
+		{3, 3, "a1 b1 c1 b2 a2 a3 c2 c3 b3", Draw}, // full board, nobody has three
+		{4, 3, "b1 a4 c2 d4 d3", XWins},            // 모서리에 닿지 않는 대각선
+		{4, 4, "a1 a2 b1 b2 c1 c2", InProgress},    // 4×4에서 셋은 이기지 않는다
+		{1, 1, "a1", XWins},
+	} {
+		b := newBoard(t, c.n, c.k, c.moves)
+		if b.State() != c.want {
+			t.Errorf("%d×%d, %d in a row, %s: %v, want %v", c.n, c.n, c.k, c.moves, b.State(), c.want)
+		}
+	}
+}
+
+// 3×3의 모든 게임을 세어서 알려진 수와 맞춰 본다: 255168 게임 중 X가 131184,
+// O가 77904번 이기고 46080번 비기며, 나오는 판은 5478가지.
+func TestAllGames(t *testing.T) {
+	b := newBoard(t, 3, 3, "")
+	games := map[State]int{}
+	positions := map[string]bool{}
+	var walk func()
+	walk = func() {
+		positions[fmt.Sprint(b.cells)] = true
+		if b.State() != InProgress {
+			games[b.State()]++
+			return
+		}
+		for _, m := range b.Legal() {
+			b.Play(m)
+			walk()
+			b.Undo()
+		}
+	}
+	walk()
+	want := map[State]int{XWins: 131184, OWins: 77904, Draw: 46080}
+	if !reflect.DeepEqual(games, want) || len(positions) != 5478 {
+		t.Errorf("games %v, positions %d; want %v, 5478", games, len(positions), want)
+	}
+}
@@ -0,0 +1,547 @@
+package tictactoe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Records
+
+// 게임 기록은 체스의 PGN을 흉내 낸 글 형식과 JSON 형식 두 가지다.
+//
+//	[Size "3"]
+//	[K "3"]
+//	[X "human"]
+//	[O "ai"]
+//	[Result "1/2-1/2"]
+//
+//	1. b2 a1 2. c3 a3 3. a2 c2 4. b1 b3 5. c1 1/2-1/2
+//
+// 위에는 [이름 "값"] 꼴의 tag가 한 줄에 하나씩 오고, 아래에는 수가 순서대로 온다.
+// "1." 같은 수 번호와 ";"부터 그 줄 끝까지의 주석은 써도 되고 안 써도 된다.
+// 마지막 결과는 1-0 (X 승), 0-1 (O 승), 1/2-1/2 (무승부), * (진행 중) 중 하나다.
+//
+// JSON은 같은 내용을 이렇게 담는다. tag의 순서는 지키지 않는다.
+//
+//	{"size": 3, "k": 3, "tags": {"O": "ai", "X": "human"},
+//	 "moves": ["b2", "a1", ...], "result": "1/2-1/2"}
+
+// Tag is a name and value pair in a record, such as X "human".
+type Tag struct {
+	Name, Value string
+}
+
+// Record is a saved game: the board, the moves and other tags such as who
+// played each side or when.
+type Record struct {
+	Size, K int
+	Tags    []Tag // Size, K and Result are kept in their own fields
+	Moves   []Move
+	Result  State
+}
+
+// NewRecord returns a record of the game on b with the given tags. It
+// returns an error wrapping ErrTag if a tag name is not made of letters,
+// digits and underscores, is Size, K or Result, or is given twice.
+func NewRecord(b *Board, tags ...Tag) (*Record, error) {
+	if err := checkTags(tags); err != nil {
+		return nil, err
+	}
+	return &Record{Size: b.n, K: b.k, Tags: tags, Moves: b.History(), Result: b.State()}, nil
+}
+
+// checkTag returns an error wrapping ErrTag if name cannot be read back
+// as a tag other than Size, K and Result.
+func checkTag(name string) error {
+	switch {
+	case !isTagName(name):
+		return fmt.Errorf("%w %q: use letters, digits and _", ErrTag, name)
+	case name == "Size" || name == "K" || name == "Result":
+		return fmt.Errorf("%w %q: the name is kept for the record's own field", ErrTag, name)
+	}
+	return nil
+}
+
+func checkTags(tags []Tag) error {
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		if err := checkTag(t.Name); err != nil {
+			return err
+		}
+		if seen[t.Name] {
+			return fmt.Errorf("%w %q: given twice", ErrTag, t.Name)
+		}
+		seen[t.Name] = true
+	}
+	return nil
+}
+
+// Tag returns the value of the tag name.
+func (r *Record) Tag(name string) (string, bool) {
+	for _, t := range r.Tags {
+		if t.Name == name {
+			return t.Value, true
+		}
+	}
+	return "", false
+}
+
+// Board replays the moves of r on a new board. The error is a *MoveError
+// for the first move that is not allowed, or wraps ErrSize.
+func (r *Record) Board() (*Board, error) {
+	b, err := New(r.Size, r.K)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range r.Moves {
+		if err := b.Play(m); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+var resultTokens = map[State]string{
+	InProgress: "*",
+	XWins:      "1-0",
+	OWins:      "0-1",
+	Draw:       "1/2-1/2",
+}
+
+func parseResult(s string) (State, bool) {
+	for st, tok := range resultTokens {
+		if tok == s {
+			return st, true
+		}
+	}
+	return 0, false
+}
+
+// ParseError is returned for a malformed record. Line and Col are 1-based
+// and point at the start of the offending part; Col counts runes. Err,
+// when not nil, is the underlying error, such as a *MoveError for an
+// illegal move.
+type ParseError struct {
+	Line, Col int
+	Msg       string
+	Err       error
+}
+
+func (e *ParseError) Error() string {
+	msg := e.Msg
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return fmt.Sprintf("tictactoe: record %d:%d: %s", e.Line, e.Col, msg)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// position converts a byte offset in src into a line and a rune column.
+func position(src []byte, off int) (line, col int) {
+	line = 1 + bytes.Count(src[:off], []byte("\n"))
+	start := bytes.LastIndexByte(src[:off], '\n') + 1
+	return line, 1 + utf8.RuneCount(src[start:off])
+}
+
+// WriteTo writes r in the text format, with lines of moves at most about
+// 72 bytes wide. It writes nothing and returns an error wrapping ErrTag if
+// a tag could not be read back, as NewRecord does.
+func (r *Record) WriteTo(w io.Writer) (int64, error) {
+	// Tags는 밖에서 바로 채울 수도 있으므로 쓰기 전에 다시 확인한다
+	if err := checkTags(r.Tags); err != nil {
+		return 0, err
+	}
+	var b strings.Builder
+	writeTag := func(name, value string) {
+		fmt.Fprintf(&b, "[%s %s]\n", name, strconv.Quote(value))
+	}
+	writeTag("Size", strconv.Itoa(r.Size))
+	writeTag("K", strconv.Itoa(r.K))
+	for _, t := range r.Tags {
+		writeTag(t.Name, t.Value)
+	}
+	writeTag("Result", resultTokens[r.Result])
+	b.WriteByte('\n')
+
+	line := 0
+	word := func(s string) {
+		if line > 0 && line+1+len(s) > 72 {
+			b.WriteByte('\n')
+			line = 0
+		}
+		if line > 0 {
+			b.WriteByte(' ')
+			line++
+		}
+		b.WriteString(s)
+		line += len(s)
+	}
+	for i, m := range r.Moves {
+		if i%2 == 0 {
+			word(fmt.Sprintf("%d.", i/2+1))
+		}
+		word(m.String())
+	}
+	word(resultTokens[r.Result])
+	b.WriteByte('\n')
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// ParseRecord reads a record in the text format, or in JSON if it starts
+// with "{". It checks that every move is legal and that the result matches
+// the moves, and reports any problem as a *ParseError.
+func ParseRecord(rd io.Reader) (*Record, error) {
+	src, err := io.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	if t := bytes.TrimLeft(src, " \t\r\n"); len(t) > 0 && t[0] == '{' {
+		r := new(Record)
+		if err := r.UnmarshalJSON(src); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	p := &recordParser{src: src}
+	return p.parse()
+}
+
+type recordParser struct {
+	src []byte
+	off int
+}
+
+func (p *recordParser) errorf(off int, err error, format string, args ...any) *ParseError {
+	line, col := position(p.src, off)
+	return &ParseError{line, col, fmt.Sprintf(format, args...), err}
+}
+
+// token returns the next token and its offset: "[", "]", a quoted string,
+// or a word. It skips spaces and comments and returns "" at the end.
+func (p *recordParser) token() (string, int, error) {
+	for p.off < len(p.src) {
+		switch c := p.src[p.off]; {
+		case c == ';':
+			for p.off < len(p.src) && p.src[p.off] != '\n' {
+				p.off++
+			}
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.off++
+		case c == '[' || c == ']':
+			p.off++
+			return string(c), p.off - 1, nil
+		case c == '"':
+			start := p.off
+			for p.off++; p.off < len(p.src) && p.src[p.off] != '"' && p.src[p.off] != '\n'; p.off++ {
+				if p.src[p.off] == '\\' {
+					p.off++
+				}
+			}
+			if p.off >= len(p.src) || p.src[p.off] != '"' {
+				return "", start, p.errorf(start, nil, "unterminated string")
+			}
+			p.off++
+			return string(p.src[start:p.off]), start, nil
+		default:
+			start := p.off
+			for p.off < len(p.src) && !strings.ContainsRune(" \t\r\n;[]\"", rune(p.src[p.off])) {
+				p.off++
+			}
+			return string(p.src[start:p.off]), start, nil
+		}
+	}
+	return "", p.off, nil
+}
+
+func (p *recordParser) parse() (*Record, error) {
+	r := &Record{Size: 3, K: 3}
+	var (
+		tok      string
+		off      int
+		err      error
+		result   = InProgress
+		resultAt = -1               // Result tag 값의 위치
+		tagAt    = map[string]int{} // tag 값의 위치
+	)
+
+	// tags
+	for {
+		if tok, off, err = p.token(); err != nil {
+			return nil, err
+		}
+		if tok != "[" {
+			break
+		}
+		name, nameAt, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if !isTagName(name) {
+			return nil, p.errorf(nameAt, nil, "want a tag name, found %s", describe(name))
+		}
+		value, valueAt, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(value, `"`) {
+			return nil, p.errorf(valueAt, nil, "want a quoted value for tag %s, found %s", name, describe(value))
+		}
+		if value, err = strconv.Unquote(value); err != nil {
+			return nil, p.errorf(valueAt, nil, "bad string %s", p.src[valueAt:p.off])
+		}
+		if end, endAt, err := p.token(); err != nil {
+			return nil, err
+		} else if end != "]" {
+			return nil, p.errorf(endAt, nil, "want ] after tag %s, found %s", name, describe(end))
+		}
+		if _, dup := tagAt[name]; dup {
+			return nil, p.errorf(nameAt, nil, "tag %s given twice", name)
+		}
+		tagAt[name] = valueAt
+
+		switch name {
+		case "Size", "K":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, p.errorf(valueAt, nil, "tag %s: want a number, found %q", name, value)
+			}
+			if name == "Size" {
+				r.Size = n
+			} else {
+				r.K = n
+			}
+		case "Result":
+			st, ok := parseResult(value)
+			if !ok {
+				return nil, p.errorf(valueAt, nil, "tag Result: want 1-0, 0-1, 1/2-1/2 or *, found %q", value)
+			}
+			result, resultAt = st, valueAt
+		default:
+			r.Tags = append(r.Tags, Tag{name, value})
+		}
+	}
+
+	b, err := New(r.Size, r.K)
+	if err != nil {
+		// Size가 맞으면 K가 틀린 것이다
+		at := tagAt["Size"]
+		if r.Size >= 1 && r.Size <= MaxSize {
+			at = tagAt["K"]
+		}
+		return nil, p.errorf(at, err, "bad board")
+	}
+
+	// moves
+	// token은 오류와 함께 ""를 돌려주므로 끝인지 보기 전에 오류부터 확인한다
+	for ; ; tok, off, err = p.token() {
+		if err != nil {
+			return nil, err
+		}
+		if tok == "" {
+			break
+		}
+		if st, ok := parseResult(tok); ok {
+			next, nextAt, err := p.token()
+			if err != nil {
+				return nil, err
+			}
+			if next != "" {
+				return nil, p.errorf(nextAt, nil, "%s after the result", describe(next))
+			}
+			if resultAt >= 0 && st != result {
+				return nil, p.errorf(off, nil, "result %s does not match the Result tag %s", tok, resultTokens[result])
+			}
+			if st != b.State() {
+				return nil, p.errorf(off, nil, "result %s, but the moves end with %v", tok, b.State())
+			}
+			r.Result = st
+			return r, nil
+		}
+		if n, ok := strings.CutSuffix(tok, "."); ok && n != "" && strings.Trim(n, "0123456789") == "" {
+			want := len(b.history)/2 + 1
+			if len(b.history)%2 != 0 || n != strconv.Itoa(want) {
+				return nil, p.errorf(off, nil, "move number %s, want %d. before X's move", tok, want)
+			}
+			continue
+		}
+		if tok == "[" {
+			return nil, p.errorf(off, nil, "tag after the moves")
+		}
+		m, err := ParseMove(tok)
+		if err != nil {
+			return nil, p.errorf(off, nil, "move %d: %s is not a move like b2", len(b.history)+1, describe(tok))
+		}
+		if err := b.Play(m); err != nil {
+			return nil, p.errorf(off, err, "move %d", len(b.history)+1)
+		}
+		r.Moves = append(r.Moves, m)
+	}
+	return nil, p.errorf(off, nil, "missing result (1-0, 0-1, 1/2-1/2 or *) at the end")
+}
+
+func isTagName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+func describe(tok string) string {
+	if tok == "" {
+		return "end of record"
+	}
+	return strconv.Quote(tok)
+}
+
+// JSON
+
+type jsonRecord struct {
+	Size   int               `json:"size"`
+	K      int               `json:"k"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Moves  []string          `json:"moves"`
+	Result string            `json:"result"`
+}
+
+// MarshalJSON encodes r as a JSON object, laid out as shown at the top of
+// record.go.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	j := jsonRecord{Size: r.Size, K: r.K, Moves: make([]string, len(r.Moves)), Result: resultTokens[r.Result]}
+	for i, m := range r.Moves {
+		j.Moves[i] = m.String()
+	}
+	if len(r.Tags) > 0 {
+		j.Tags = make(map[string]string, len(r.Tags))
+		for _, t := range r.Tags {
+			j.Tags[t.Name] = t.Value
+		}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a record written by MarshalJSON, checking it the
+// way ParseRecord does. Errors are *ParseError with the position in data;
+// tags come out sorted by name.
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var j jsonRecord
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&j); err != nil {
+		off := int(dec.InputOffset())
+		var se *json.SyntaxError
+		var te *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &se):
+			off = int(se.Offset) - 1 // Offset은 잘못된 글자 다음을 가리킨다
+		case errors.As(err, &te) && te.Field != "" && !strings.Contains(te.Field, "."):
+			off = jsonKeyOffset(data, te.Field)
+		case errors.As(err, &te):
+			off = int(te.Offset)
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			off = len(data)
+		}
+		if off > len(data) {
+			off = len(data)
+		}
+		line, col := position(data, off)
+		return &ParseError{line, col, "bad JSON", err}
+	}
+
+	// 수마다 위치를 알려면 data에서 그 문자열을 찾아야 한다.
+	// "moves" 배열 안에서 차례로 찾아 나간다.
+	movesAt := jsonKeyOffset(data, "moves")
+	errorAt := func(key string, err error, format string, args ...any) error {
+		line, col := position(data, jsonKeyOffset(data, key))
+		return &ParseError{line, col, fmt.Sprintf(format, args...), err}
+	}
+
+	b, err := New(j.Size, j.K)
+	if err != nil {
+		if j.Size >= 1 && j.Size <= MaxSize {
+			return errorAt("k", err, "bad board")
+		}
+		return errorAt("size", err, "bad board")
+	}
+	rec := Record{Size: j.Size, K: j.K}
+	search := movesAt
+	for i, s := range j.Moves {
+		at := search
+		if k := bytes.Index(data[search:], []byte(strconv.Quote(s))); k >= 0 {
+			at = search + k
+			search = at + len(strconv.Quote(s))
+		}
+		line, col := position(data, at)
+		m, err := ParseMove(s)
+		if err != nil {
+			return &ParseError{line, col, fmt.Sprintf("move %d: %q is not a move like b2", i+1, s), nil}
+		}
+		if err := b.Play(m); err != nil {
+			return &ParseError{line, col, fmt.Sprintf("move %d", i+1), err}
+		}
+		rec.Moves = append(rec.Moves, m)
+	}
+	st, ok := parseResult(j.Result)
+	switch {
+	case !ok:
+		return errorAt("result", nil, "result: want 1-0, 0-1, 1/2-1/2 or *, found %q", j.Result)
+	case st != b.State():
+		return errorAt("result", nil, "result %s, but the moves end with %v", j.Result, b.State())
+	}
+	rec.Result = st
+	names := make([]string, 0, len(j.Tags))
+	for name := range j.Tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := checkTag(name); err != nil {
+			return errorAt("tags", err, "bad tag")
+		}
+		rec.Tags = append(rec.Tags, Tag{name, j.Tags[name]})
+	}
+	*r = rec
+	return nil
+}
+
+// jsonKeyOffset returns the offset of the value of the top-level key in
+// data, or 0 if it is not there. It is only used to point errors at the
+// right place.
+func jsonKeyOffset(data []byte, key string) int {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return 0
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return 0
+		}
+		off := int(dec.InputOffset())
+		if t == key {
+			// ':'와 공백을 건너뛴다
+			for off < len(data) && strings.IndexByte(" \t\r\n:", data[off]) >= 0 {
+				off++
+			}
+			return off
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return 0
+		}
+	}
+	return 0
+}
@@ -0,0 +1,236 @@
+package tictactoe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var recordTags = []Tag{{"X", "사람"}, {"O", `ai "depth 2"`}, {"Date", "2026-10-18"}}
+
+const recordText = `[Size "3"]
+[K "3"]
+[X "사람"]
+[O "ai \"depth 2\""]
+[Date "2026-10-18"]
+[Result "1/2-1/2"]
+
+1. b2 a1 2. b1 b3 3. a3 c1 4. c2 a2 5. c3 1/2-1/2
+`
+
+const recordJSON = `{"size":3,"k":3,"tags":{"Date":"2026-10-18","O":"ai \"depth 2\"","X":"사람"},` +
+	`"moves":["b2","a1","b1","b3","a3","c1","c2","a2","c3"],"result":"1/2-1/2"}`
+
+func TestRecord(t *testing.T) {
+	b := newBoard(t, 3, 3, "b2 a1 b1 b3 a3 c1 c2 a2 c3")
+	rec, err := NewRecord(b, recordTags...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text bytes.Buffer
+	n, err := rec.WriteTo(&text)
+	if err != nil || n != int64(text.Len()) || text.String() != recordText {
+		t.Errorf("WriteTo = %d, %v:\n%s\nwant\n%s", n, err, text.String(), recordText)
+	}
+	js, err := json.Marshal(rec)
+	if err != nil || string(js) != recordJSON {
+		t.Errorf("json.Marshal = %s, %v\nwant %s", js, err, recordJSON)
+	}
+
+	// JSON의 tag는 object라서 이름 순서로 읽힌다
+	sorted := *rec
+	sorted.Tags = []Tag{recordTags[2], recordTags[1], recordTags[0]}
+	for _, c := range []struct {
+		src  string
+		want *Record
+	}{
+		{recordText, rec},
+		{recordJSON, &sorted},
+	} {
+		got, err := ParseRecord(strings.NewReader(c.src))
+		if err != nil {
+			t.Errorf("ParseRecord(%.20q...): %v", c.src, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ParseRecord(%.20q...) = %+v, want %+v", c.src, got, c.want)
+		}
+		replayed, err := got.Board()
+		if err != nil || replayed.String() != b.String() || replayed.State() != Draw {
+			t.Errorf("Board() = %v, %v", replayed, err)
+		}
+	}
+
+	var viaStd Record
+	if err := json.Unmarshal([]byte(recordJSON), &viaStd); err != nil || !reflect.DeepEqual(&viaStd, &sorted) {
+		t.Errorf("json.Unmarshal = %+v, %v", viaStd, err)
+	}
+	if v, ok := rec.Tag("O"); !ok || v != `ai "depth 2"` {
+		t.Errorf("Tag(O) = %q, %v", v, ok)
+	}
+	if _, ok := rec.Tag("Event"); ok {
+		t.Error("Tag(Event) found")
+	}
+}
+
+// 긴 게임은 줄을 나눠 쓰고, 읽으면 같은 record가 나와야 한다.
+func TestRecordRoundTrip(t *testing.T) {
+	b := newBoard(t, 9, 5, "")
+	ai := newAI(t, WithDepth(1))
+	for b.State() == InProgress {
+		r, err := ai.Best(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Play(r.Move)
+	}
+	rec, err := NewRecord(b, recordTags...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text bytes.Buffer
+	if _, err := rec.WriteTo(&text); err != nil {
+		t.Fatal(err)
+	}
+	for i, line := range strings.Split(text.String(), "\n") {
+		if len(line) > 80 {
+			t.Errorf("line %d has %d bytes: %s", i+1, len(line), line)
+		}
+	}
+	got, err := ParseRecord(&text)
+	if err != nil || !reflect.DeepEqual(got, rec) {
+		t.Errorf("ParseRecord = %+v, %v, want %+v", got, err, rec)
+	}
+	js, _ := json.Marshal(rec)
+	got, err = ParseRecord(bytes.NewReader(js))
+	if err != nil || !reflect.DeepEqual(got.Moves, rec.Moves) || got.Result != rec.Result {
+		t.Errorf("ParseRecord(JSON) = %+v, %v, want %+v", got, err, rec)
+	}
+}
+
+func TestParseRecordNoTags(t *testing.T) {
+	rec, err := ParseRecord(strings.NewReader("; 주석만 있는 줄\n1. b2 ; 가운데\na1 c3 *"))
+	want := &Record{Size: 3, K: 3, Moves: []Move{{1, 1}, {0, 0}, {2, 2}}, Result: InProgress}
+	if err != nil || !reflect.DeepEqual(rec, want) {
+		t.Errorf("ParseRecord = %+v, %v, want %+v", rec, err, want)
+	}
+}
+
+func TestParseRecordErrors(t *testing.T) {
+	for _, c := range []struct {
+		src  string
+		want string // "tictactoe: record " 뒤
+		err  error  // errors.Is로 확인할 원인, 없으면 nil
+	}{
+		{"", "1:1: missing result (1-0, 0-1, 1/2-1/2 or *) at the end", nil},
+		{"1. b2 a1", "1:9: missing result (1-0, 0-1, 1/2-1/2 or *) at the end", nil},
+		{"[Size \"3\"\n1. b2 *", `2:1: want ] after tag Size, found "1."`, nil},
+		{"[Size 3]\n*", `1:7: want a quoted value for tag Size, found "3"`, nil},
+		{"[\"Size\" \"3\"]\n*", `1:2: want a tag name, found "\"Size\""`, nil},
+		{"[X \"사람]\n*", "1:4: unterminated string", nil},
+		{"[X \"bad \\q\"]\n*", `1:4: bad string "bad \q"`, nil},
+		{"[X \"a\"]\n[X \"b\"]\n*", "2:2: tag X given twice", nil},
+		{"[Size \"three\"]\n*", `1:7: tag Size: want a number, found "three"`, nil},
+		{"[Size \"30\"]\n*", "1:7: bad board: tictactoe: invalid board size: 30×30 with 3 in a row", ErrSize},
+		{"[Size \"3\"]\n[K \"4\"]\n*", "2:4: bad board: tictactoe: invalid board size: 3×3 with 4 in a row", ErrSize},
+		{"1. b2 a1 2. zz *", `1:13: move 3: "zz" is not a move like b2`, nil},
+		{"1. b2 a1 2. a1 *", "1:13: move 3: tictactoe: X cannot play a1: cell is taken", ErrOccupied},
+		{"1. b2 a1 2. d1 *", "1:13: move 3: tictactoe: X cannot play d1: off the board", ErrOffBoard},
+		{"1. b2 a1 3. c3 *", "1:10: move number 3., want 2. before X's move", nil},
+		{"1. b2 2. a1 *", "1:7: move number 2., want 1. before X's move", nil},
+		{"[X \"사람\"] 1. a1 b1 2. a2 b2 3. a3 b3 *", "1:34: move 6: tictactoe: O cannot play b3: game is over", ErrGameOver},
+		{"1. a1 b1 2. a2 b2 3. a3 1/2-1/2", "1:25: result 1/2-1/2, but the moves end with X wins", nil},
+		{"[Result \"0-1\"]\n\n1. a1 b1 2. a2 b2 3. a3 1-0", "3:25: result 1-0 does not match the Result tag 0-1", nil},
+		{"1. b2 [X \"late\"] *", "1:7: tag after the moves", nil},
+		{"1. b2 * a1", `1:9: "a1" after the result`, nil},
+		// 끝나지 않은 string은 끝으로 보지 않고 그대로 알린다
+		{"1. b2 \"oops", "1:7: unterminated string", nil},
+		{"1. b2 * \"oops", "1:9: unterminated string", nil},
+	} {
+		rec, err := ParseRecord(strings.NewReader(c.src))
+		var pe *ParseError
+		if rec != nil || !errors.As(err, &pe) {
+			t.Errorf("ParseRecord(%q) = %v, %v, want a *ParseError", c.src, rec, err)
+			continue
+		}
+		if got := strings.TrimPrefix(err.Error(), "tictactoe: record "); got != c.want {
+			t.Errorf("ParseRecord(%q):\n got %s\nwant %s", c.src, got, c.want)
+		}
+		if c.err != nil && !errors.Is(err, c.err) {
+			t.Errorf("ParseRecord(%q): %v does not wrap %v", c.src, err, c.err)
+		}
+		var me *MoveError
+		if errors.As(err, &me) != (c.err == ErrOccupied || c.err == ErrOffBoard || c.err == ErrGameOver) {
+			t.Errorf("ParseRecord(%q): MoveError %v", c.src, me)
+		}
+	}
+}
+
+func TestParseRecordJSONErrors(t *testing.T) {
+	for _, c := range []struct{ src, want string }{
+		{"{\"size\": 3,\n \"k\": 3,\n \"moves\": [\"b2\" \"a1\"]}",
+			`3:17: bad JSON: invalid character '"' after array element`},
+		{`{"size": "3", "k": 3, "moves": [], "result": "*"}`,
+			"1:10: bad JSON: json: cannot unmarshal string into Go struct field jsonRecord.size of type int"},
+		{`{"size": 3, "k": 3, "moves": [], "result": "*", "winner": "X"}`,
+			`1:63: bad JSON: json: unknown field "winner"`},
+		{`{"size": 3, "k": 4, "moves": [], "result": "*"}`,
+			"1:18: bad board: tictactoe: invalid board size: 3×3 with 4 in a row"},
+		{"{\"size\": 3, \"k\": 3,\n \"moves\": [\"b2\", \"a1\",\n           \"b2\"], \"result\": \"*\"}",
+			"3:12: move 3: tictactoe: X cannot play b2: cell is taken"},
+		{`{"size": 3, "k": 3, "moves": ["b2", "a1", "a1", "zz"], "result": "*"}`,
+			"1:43: move 3: tictactoe: X cannot play a1: cell is taken"},
+		{"{\"size\": 3, \"k\": 3, \"moves\": [\"b2\"],\n \"result\": \"1-0\"}",
+			"2:12: result 1-0, but the moves end with in progress"},
+		{`{"size": 3, "k": 3, "moves": ["b2"], "result": "X"}`,
+			`1:48: result: want 1-0, 0-1, 1/2-1/2 or *, found "X"`},
+		{`  {"size": 3,`, "1:14: bad JSON: unexpected EOF"},
+		{`{"size": 3, "k": 3, "tags": {"Size": "9"}, "moves": [], "result": "*"}`,
+			`1:29: bad tag: tictactoe: invalid tag "Size": the name is kept for the record's own field`},
+		{`{"size": 3, "k": 3, "tags": {"my tag": "x"}, "moves": [], "result": "*"}`,
+			`1:29: bad tag: tictactoe: invalid tag "my tag": use letters, digits and _`},
+	} {
+		_, err := ParseRecord(strings.NewReader(c.src))
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ParseRecord(%q): err = %v, want a *ParseError", c.src, err)
+			continue
+		}
+		if got := strings.TrimPrefix(err.Error(), "tictactoe: record "); got != c.want {
+			t.Errorf("ParseRecord(%q):\n got %s\nwant %s", c.src, got, c.want)
+		}
+	}
+}
+
+// 다시 읽을 수 없는 tag는 NewRecord도 WriteTo도 받지 않는다.
+func TestBadTags(t *testing.T) {
+	b := newBoard(t, 3, 3, "")
+	for _, c := range []struct {
+		tags []Tag
+		want string
+	}{
+		{[]Tag{{"Result", "1-0"}}, `"Result": the name is kept for the record's own field`},
+		{[]Tag{{"Size", "9"}}, `"Size": the name is kept for the record's own field`},
+		{[]Tag{{"K", "9"}}, `"K": the name is kept for the record's own field`},
+		{[]Tag{{"my tag", "x"}}, `"my tag": use letters, digits and _`},
+		{[]Tag{{"", "x"}}, `"": use letters, digits and _`},
+		{[]Tag{{"X", "a"}, {"X", "b"}}, `"X": given twice`},
+	} {
+		want := "tictactoe: invalid tag " + c.want
+		rec, err := NewRecord(b, c.tags...)
+		if rec != nil || !errors.Is(err, ErrTag) || err.Error() != want {
+			t.Errorf("NewRecord(%v) = %v, %v, want %s", c.tags, rec, err, want)
+		}
+		var buf bytes.Buffer
+		n, err := (&Record{Size: 3, K: 3, Tags: c.tags}).WriteTo(&buf)
+		if n != 0 || buf.Len() != 0 || !errors.Is(err, ErrTag) || err.Error() != want {
+			t.Errorf("WriteTo with %v = %d, %v, wrote %q", c.tags, n, err, buf.String())
+		}
+	}
+	if _, err := NewRecord(b, Tag{"Event_2", "x"}, Tag{"x", "y"}); err != nil {
+		t.Errorf("NewRecord with good tags: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Package transform provides io.Readers that wrap another io.Reader and
+// rewrite the stream byte by byte, like rot13Reader in the tour exercise.
+// Every constructor takes and returns an io.Reader, so they chain:
+//
+//	r := transform.Upper(transform.ROT13(strings.NewReader("Lbh penpxrq gur pbqr!")))
+//
+// Only ASCII letters are rewritten. Bytes of multi-byte UTF-8 sequences are
+// always >= 0x80 and pass through unchanged, so non-ASCII text such as
+// Hangul survives any chain intact.
+package transform
+
+import (
+	"io"
+
+	"go-study/my_practice/utils"
+)
+
+// mapReader applies f to every byte read from r.
+type mapReader struct {
+	r io.Reader
+	f func(byte) byte
+}
+
+func (m *mapReader) Read(b []byte) (int, error) {
+	n, err := m.r.Read(b)
+	for i := 0; i < n; i++ {
+		b[i] = m.f(b[i])
+	}
+	return n, err
+}
+
+// Map returns a reader that yields f(c) for every byte c of r.
+func Map(r io.Reader, f func(byte) byte) io.Reader {
+	return &mapReader{r, f}
+}
+
+// Caesar shifts ASCII letters of r by shift places, wrapping within
+// A–Z and a–z. A negative shift moves backwards, so Caesar(Caesar(r, n), -n)
+// reads the original stream.
+func Caesar(r io.Reader, shift int) io.Reader {
+	k := byte((shift%26 + 26) % 26)
+	return Map(r, func(c byte) byte {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			return 'A' + (c-'A'+k)%26
+		case c >= 'a' && c <= 'z':
+			return 'a' + (c-'a'+k)%26
+		}
+		return c
+	})
+}
+
+// ROT13 is Caesar with a shift of 13; applying it twice is the identity.
+func ROT13(r io.Reader) io.Reader {
+	return Caesar(r, 13)
+}
+
+// Atbash mirrors the alphabet: A↔Z, B↔Y, and so on, preserving case.
+func Atbash(r io.Reader) io.Reader {
+	return Map(r, func(c byte) byte {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			return 'Z' - (c - 'A')
+		case c >= 'a' && c <= 'z':
+			return 'z' - (c - 'a')
+		}
+		return c
+	})
+}
+
+// Upper maps a–z to A–Z using the same rule as utils.ToUpper2.
+func Upper(r io.Reader) io.Reader {
+	return Map(r, func(c byte) byte {
+		return byte(utils.UpperASCII(rune(c)))
+	})
+}
+
+// Lower maps A–Z to a–z.
+func Lower(r io.Reader) io.Reader {
+	return Map(r, func(c byte) byte {
+		return byte(utils.LowerASCII(rune(c)))
+	})
+}
+
+// Substitute replaces every byte from[i] in r with to[i]; other bytes pass
+// through. Like strings.NewReplacer with mismatched arguments, it panics if
+// from and to differ in length. If a byte appears more than once in from,
+// the last mapping wins.
+func Substitute(r io.Reader, from, to []byte) io.Reader {
+	if len(from) != len(to) {
+		panic("transform.Substitute: from and to differ in length")
+	}
+	var table [256]byte
+	for i := range table {
+		table[i] = byte(i)
+	}
+	for i, c := range from {
+		table[c] = to[i]
+	}
+	return Map(r, func(c byte) byte { return table[c] })
+}
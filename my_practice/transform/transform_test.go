@@ -0,0 +1,102 @@
+package transform
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"go-study/my_practice/readercheck"
+)
+
+const input = "Lbh penpxrq gur pbqr! Hello, 월드."
+
+// sources는 같은 입력을 여러 방식으로 잘라서 준다.
+// 어떻게 잘려 들어와도 결과는 같아야 한다.
+var sources = []struct {
+	name string
+	wrap func(io.Reader) io.Reader
+}{
+	{"plain", func(r io.Reader) io.Reader { return r }},
+	{"OneByteReader", iotest.OneByteReader},
+	{"HalfReader", iotest.HalfReader},
+	{"DataErrReader", iotest.DataErrReader},
+}
+
+// check reads src through tr from every source and compares it with want.
+func check(t *testing.T, name string, tr func(io.Reader) io.Reader, src, want string) {
+	t.Helper()
+	for _, s := range sources {
+		got, err := io.ReadAll(tr(s.wrap(strings.NewReader(src))))
+		if err != nil {
+			t.Errorf("%s(%q) via %s: %v", name, src, s.name, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s(%q) via %s = %q, want %q", name, src, s.name, got, want)
+		}
+	}
+	// iotest.DataErrReader는 길이 0인 버퍼를 받으면 무한 루프에 빠지므로
+	// readercheck에는 OneByteReader와 HalfReader만 쓴다.
+	for _, s := range sources[:3] {
+		if err := readercheck.Validate(tr(s.wrap(strings.NewReader(src)))); err != nil {
+			t.Errorf("%s via %s: %v", name, s.name, err)
+		}
+	}
+}
+
+func TestCaesar(t *testing.T) {
+	for _, c := range []struct {
+		shift int
+		want  string
+	}{
+		{3, "Oek shqsaut jxu setu! Khoor, 월드."},
+		{-3, "Iye mbkmuon dro myno! Ebiil, 월드."},
+		{0, input},
+		{26, input},
+		{29, "Oek shqsaut jxu setu! Khoor, 월드."},
+		{-29, "Iye mbkmuon dro myno! Ebiil, 월드."},
+	} {
+		shift := c.shift
+		check(t, "Caesar", func(r io.Reader) io.Reader { return Caesar(r, shift) }, input, c.want)
+	}
+	check(t, "Caesar-Caesar", func(r io.Reader) io.Reader { return Caesar(Caesar(r, 3), -3) }, input, input)
+	check(t, "Caesar", func(r io.Reader) io.Reader { return Caesar(r, 1) }, "Zz Aa", "Aa Bb")
+}
+
+func TestROT13Reader(t *testing.T) {
+	check(t, "ROT13", ROT13, input, "You cracked the code! Uryyb, 월드.")
+	check(t, "ROT13", ROT13,
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ abcdefghijklmnopqrstuvwxyz",
+		"NOPQRSTUVWXYZABCDEFGHIJKLM nopqrstuvwxyzabcdefghijklm")
+	check(t, "ROT13∘ROT13", func(r io.Reader) io.Reader { return ROT13(ROT13(r)) }, input, input)
+}
+
+func TestAtbash(t *testing.T) {
+	check(t, "Atbash", Atbash, input, "Oys kvmkcij tfi kyji! Svool, 월드.")
+	check(t, "Atbash", Atbash, "AZaz", "ZAza")
+	check(t, "Atbash∘Atbash", func(r io.Reader) io.Reader { return Atbash(Atbash(r)) }, input, input)
+}
+
+func TestCase(t *testing.T) {
+	check(t, "Upper", Upper, input, "LBH PENPXRQ GUR PBQR! HELLO, 월드.")
+	check(t, "Lower", Lower, input, "lbh penpxrq gur pbqr! hello, 월드.")
+	// 한 글자씩 바꾸므로 ASCII 밖의 글자는 그대로 둔다
+	check(t, "Upper", Upper, "straße é", "STRAßE é")
+	check(t, "Upper∘ROT13", func(r io.Reader) io.Reader { return Upper(ROT13(r)) }, input, "YOU CRACKED THE CODE! URYYB, 월드.")
+}
+
+func TestSubstitute(t *testing.T) {
+	leet := func(r io.Reader) io.Reader { return Substitute(r, []byte("aeiou"), []byte("43105")) }
+	check(t, "Substitute", leet, input, "Lbh p3npxrq g5r pbqr! H3ll0, 월드.")
+	// 같은 byte가 두 번 나오면 나중 것이 이긴다
+	twice := func(r io.Reader) io.Reader { return Substitute(r, []byte("aa"), []byte("xy")) }
+	check(t, "Substitute", twice, "banana", "bynyny")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Substitute with from and to of different lengths did not panic")
+		}
+	}()
+	Substitute(strings.NewReader(""), []byte("ab"), []byte("a"))
+}
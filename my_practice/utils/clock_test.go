@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	c := NewFakeClock(goldenTime)
+	c.Advance(90 * time.Minute)
+	if want := goldenTime.Add(90 * time.Minute); !c.Now().Equal(want) {
+		t.Errorf("after Advance: %v, want %v", c.Now(), want)
+	}
+	c.Set(goldenTime)
+	if !c.Now().Equal(goldenTime) {
+		t.Errorf("after Set: %v, want %v", c.Now(), goldenTime)
+	}
+}
+
+// 시각에 따라 다른 case로 가는 예제가 시계를 바꿔 끼운 대로 답하는지 확인한다
+func TestTimeDependentExercises(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2009, 11, d, h, 0, 0, 0, time.UTC) }
+	for _, c := range []struct {
+		id   string
+		at   time.Time
+		want string
+	}{
+		{"2_10", day(14, 23), "When's Saturday?\nToday!\n"},
+		{"2_10", day(13, 23), "When's Saturday?\nTomorrow.\n"},
+		{"2_10", day(12, 23), "When's Saturday?\nIn two days.\n"},
+		{"2_10", day(10, 23), "When's Saturday?\nToo far away.\n"},
+		{"2_11", day(10, 9), "Good morning.\n"},
+		{"2_11", day(10, 14), "Good afternoon.\n"},
+		{"2_11", day(10, 23), "Good evening.\n"},
+		{"4_19", day(10, 23), "it didn't work (at 2009-11-10 23:00:00 +0000 UTC)\n"},
+	} {
+		e, ok := Lookup(c.id)
+		if !ok {
+			t.Fatalf("no exercise %s", c.id)
+		}
+		if got := string(capture(e, c.at)); got != c.want {
+			t.Errorf("%s at %v printed %q, want %q", c.id, c.at, got, c.want)
+		}
+	}
+}
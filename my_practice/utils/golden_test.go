@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-study/my_practice/internal/golden"
+)
+
+// Golden files
+
+// 각 연습문제의 출력을 testdata/<ID>.golden 에 저장해두고,
+// 테스트할 때마다 비교해서 출력이 바뀌면 알려준다.
+// 출력을 의도적으로 바꿨다면 go test -update로 golden 파일을 다시 만든다.
+
+// goldenTime is the instant the clock is pinned to while recording golden
+// output: the Go playground's famous 2009-11-10 23:00 UTC.
+var goldenTime = time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC)
+
+// 시간에 따라 switch의 다른 case로 가는 예제는 goldenTime 외의 시각에서도
+// golden 파일을 따로 만들어 모든 branch를 확인한다.
+// goldenTime(화요일 23시)은 "Too far away."와 "Good evening."을 맡는다.
+var goldenVariants = []struct {
+	ID, Name string
+	At       time.Time
+}{
+	{"2_10", "saturday", time.Date(2009, 11, 14, 23, 0, 0, 0, time.UTC)},
+	{"2_10", "friday", time.Date(2009, 11, 13, 23, 0, 0, 0, time.UTC)},
+	{"2_10", "thursday", time.Date(2009, 11, 12, 23, 0, 0, 0, time.UTC)},
+	{"2_11", "morning", time.Date(2009, 11, 10, 9, 0, 0, 0, time.UTC)},
+	{"2_11", "afternoon", time.Date(2009, 11, 10, 14, 0, 0, 0, time.UTC)},
+}
+
+// capture runs e with the clock pinned to at and returns what it printed.
+// A recovered panic is appended as a final "panic: ..." line so that the
+// exercises that panic on purpose have stable golden output too.
+func capture(e Exercise, at time.Time) []byte {
+	prevClock, prevGOOS := clock, goos
+	clock, goos = NewFakeClock(at), "linux"
+	defer func() { clock, goos = prevClock, prevGOOS }()
+
+	var buf bytes.Buffer
+	if err := e.RunTo(&buf); err != nil {
+		fmt.Fprintln(&buf, err)
+	}
+	return buf.Bytes()
+}
+
+// TestGolden compares every registered exercise, plus the clock variants
+// of the time-dependent ones, with its golden file. Golden files record
+// what an exercise printed when it was last checked by hand; the exercises
+// with real logic also have their own tests.
+func TestGolden(t *testing.T) {
+	for _, e := range Exercises() {
+		golden.Check(t, e.ID, capture(e, goldenTime))
+	}
+	for _, v := range goldenVariants {
+		e, ok := Lookup(v.ID)
+		if !ok {
+			t.Fatalf("golden variant for unknown exercise %s", v.ID)
+		}
+		golden.Check(t, v.ID+"."+v.Name, capture(e, v.At))
+	}
+}
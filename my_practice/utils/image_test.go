@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"testing"
+
+	"go-study/my_practice/pic"
+)
+
+func TestImage(t *testing.T) {
+	const w, h = 70, 40
+	for name, f := range PicFormulas3_18 {
+		want := PicFunc3_18(f)(w, h)
+		gray := Image{w, h, f, color.GrayModel}
+		rgba := Image{w, h, f, color.RGBAModel}
+		if got := gray.Bounds(); got != image.Rect(0, 0, w, h) {
+			t.Fatalf("%s: Bounds = %v", name, got)
+		}
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				v := want[y][x]
+				if got := gray.At(x, y); got != (color.Gray{v}) {
+					t.Fatalf("%s: gray At(%d, %d) = %v, want %v", name, x, y, got, color.Gray{v})
+				}
+				if got := rgba.At(x, y); got != (color.RGBA{v, v, 255, 255}) {
+					t.Fatalf("%s: rgba At(%d, %d) = %v, want %v", name, x, y, got, color.RGBA{v, v, 255, 255})
+				}
+			}
+		}
+	}
+
+	m := Image{w, h, PicFormulas3_18["x^y"], color.GrayModel}
+	if got := m.At(w, 0); got != (color.Gray{}) {
+		t.Errorf("At outside the bounds = %v, want transparent", got)
+	}
+	// PNG로 저장했다가 다시 읽어도 같은 값이어야 한다
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	back, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if got, want := color.GrayModel.Convert(back.At(x, y)), m.At(x, y); got != want {
+				t.Fatalf("PNG round trip At(%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+// Pic3_18처럼 [][]uint8을 통째로 만드는 방식(slices)과
+// Image처럼 At에서 그때그때 계산하는 방식(lazy)을 크기별로 비교한다.
+
+var imageSizes = []int{256, 1024, 2048}
+
+// sink keeps the compiler from optimizing the scans away.
+var sink int
+
+// 모든 픽셀 값을 한 번씩 읽는다
+func BenchmarkImageScan(b *testing.B) {
+	for _, n := range imageSizes {
+		b.Run(fmt.Sprintf("slices/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				for _, row := range Pic3_18(n, n) {
+					for _, v := range row {
+						sum += int(v)
+					}
+				}
+				sink = sum
+			}
+		})
+		b.Run(fmt.Sprintf("lazy/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			m := Image{n, n, PicFormulas3_18["(x+y)/2"], color.GrayModel}
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				for y := 0; y < n; y++ {
+					for x := 0; x < n; x++ {
+						sum += int(m.At(x, y).(color.Gray).Y)
+					}
+				}
+				sink = sum
+			}
+		})
+	}
+}
+
+// image/draw로 RGBA 이미지에 복사한다 (PNG로 저장하기 전 단계)
+func BenchmarkImageDraw(b *testing.B) {
+	for _, n := range imageSizes {
+		b.Run(fmt.Sprintf("slices/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			dst := image.NewRGBA(image.Rect(0, 0, n, n))
+			for i := 0; i < b.N; i++ {
+				src, err := pic.FromFunc(Pic3_18, n, n)
+				if err != nil {
+					b.Fatal(err)
+				}
+				draw.Draw(dst, dst.Bounds(), src, image.Point{}, draw.Src)
+			}
+		})
+		b.Run(fmt.Sprintf("lazy/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			dst := image.NewRGBA(image.Rect(0, 0, n, n))
+			src := Image{n, n, PicFormulas3_18["(x+y)/2"], color.GrayModel}
+			for i := 0; i < b.N; i++ {
+				draw.Draw(dst, dst.Bounds(), src, image.Point{}, draw.Src)
+			}
+		})
+	}
+}
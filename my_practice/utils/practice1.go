@@ -6,6 +6,14 @@ import (
 	"math/cmplx"
 )
 
+func init() {
+	Register(Exercise{"1_1", 1, "Basic types", Practice1_1})
+	Register(Exercise{"1_2", 1, "Type conversions", Practice1_2})
+	Register(Exercise{"1_3", 1, "Type inference", Practice1_3})
+	Register(Exercise{"1_4", 1, "Constants", Practice1_4})
+	Register(Exercise{"1_5", 1, "Numeric constants", Practice1_5})
+}
+
 // // A var declaration can include initializers, one per variable.
 // // If an initializer is present, the type can be omitted; the variable will take the type of the initializer.
 // var i, j int = 1, 2 // var i, j = 1, 2 가능
@@ -22,9 +30,9 @@ var (
 )
 
 func Practice1_1() {
-	fmt.Printf("Type: %T Value: %v\n", ToBe, ToBe)
-	fmt.Printf("Type: %T Value: %v\n", MaxInt, MaxInt)
-	fmt.Printf("Type: %T Value: %v\n", z, z)
+	fmt.Fprintf(out, "Type: %T Value: %v\n", ToBe, ToBe)
+	fmt.Fprintf(out, "Type: %T Value: %v\n", MaxInt, MaxInt)
+	fmt.Fprintf(out, "Type: %T Value: %v\n", z, z)
 }
 
 
@@ -38,7 +46,7 @@ func Practice1_2() {
 	var x, y = 3, 4
 	var f float64 = math.Sqrt(float64(x*x + y*y))
 	var z uint = uint(f)
-	fmt.Println(x, y, z)
+	fmt.Fprintln(out, x, y, z)
 }
 
 
@@ -46,20 +54,20 @@ func Practice1_3() {
 	v := 42           // int
 	f := 3.142        // float64
 	g := 0.867 + 0.5i // complex128
-	fmt.Printf("v is of type %T\n", v)
-	fmt.Printf("f is of type %T\n", f)
-	fmt.Printf("g is of type %T\n", g)
+	fmt.Fprintf(out, "v is of type %T\n", v)
+	fmt.Fprintf(out, "f is of type %T\n", f)
+	fmt.Fprintf(out, "g is of type %T\n", g)
 }
 
 const Pi = 3.14
 
 func Practice1_4() {
 	const World = "세계"
-	fmt.Println("Hello", World)
-	fmt.Println("Happy", Pi, "Day")
+	fmt.Fprintln(out, "Hello", World)
+	fmt.Fprintln(out, "Happy", Pi, "Day")
 
 	const Truth = true
-	fmt.Println("Go rules?", Truth)
+	fmt.Fprintln(out, "Go rules?", Truth)
 }
 
 const (
@@ -74,7 +82,7 @@ func needFloat(x float64) float64 {
 	return x * 0.1
 }
 func Practice1_5() {
-	fmt.Println(needInt(Small))
-	fmt.Println(needFloat(Small))
-	fmt.Println(needFloat((Big)))
+	fmt.Fprintln(out, needInt(Small))
+	fmt.Fprintln(out, needFloat(Small))
+	fmt.Fprintln(out, needFloat((Big)))
 }
\ No newline at end of file
@@ -5,10 +5,27 @@ package utils
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 	"runtime"
 	"time"
 )
 
+func init() {
+	Register(Exercise{"2_1", 2, "For", Practice2_1})
+	Register(Exercise{"2_2", 2, "For continued", Practice2_2})
+	Register(Exercise{"2_3", 2, "For is Go's while", Practice2_3})
+	Register(Exercise{"2_4", 2, "Forever", Practice2_4})
+	Register(Exercise{"2_5", 2, "If", Practice2_5})
+	Register(Exercise{"2_6", 2, "If with a short statement", Practice2_6})
+	Register(Exercise{"2_7", 2, "If and else", Practice2_7})
+	Register(Exercise{"2_8", 2, "Exercise: Loops and Functions", Practice2_8})
+	Register(Exercise{"2_9", 2, "Switch", Practice2_9})
+	Register(Exercise{"2_10", 2, "Switch evaluation order", Practice2_10})
+	Register(Exercise{"2_11", 2, "Switch with no condition", Practice2_11})
+	Register(Exercise{"2_12", 2, "Defer", Practice2_12})
+	Register(Exercise{"2_13", 2, "Stacking defers", Practice2_13})
+}
+
 // Note: Unlike other languages like C, Java, or JavaScrip
 // there are no parentheses surrounding the three components of the for statement,
 // and the braces { } are always required.
@@ -25,7 +42,7 @@ func Practice2_1() {
 	for i:=0; i < 10; i++ {
 		sum += i
 	}
-	fmt.Println(sum)
+	fmt.Fprintln(out, sum)
 }
 
 func Practice2_2() {
@@ -34,7 +51,7 @@ func Practice2_2() {
 	for ; sum < 1000; {
 		sum += sum
 	}
-	fmt.Println(sum)
+	fmt.Fprintln(out, sum)
 }
 
 func Practice2_3() {
@@ -43,7 +60,7 @@ func Practice2_3() {
 	for sum < 1000 {
 		sum += sum
 	}
-	fmt.Println(sum)
+	fmt.Fprintln(out, sum)
 }
 
 func Practice2_4() {
@@ -62,7 +79,11 @@ func sqrt2_5(x float64) string {
 }
 
 func Practice2_5() {
-	fmt.Println(sqrt2_5(2), sqrt2_5(-4))	
+	fmt.Fprintln(out, sqrt2_5(2), sqrt2_5(-4))	
+
+	// sqrt2_5는 문자열에 "i"를 붙일 뿐이므로, 진짜 complex128 결과와 비교
+	r, _ := ComplexRoot(-4, 2)
+	fmt.Fprintln(out, r, cmplx.Sqrt(-4))
 }
 
 func pow2_6(x, n, lim float64) float64 {
@@ -75,7 +96,7 @@ func pow2_6(x, n, lim float64) float64 {
 }
 
 func Practice2_6() {
-	fmt.Println(
+	fmt.Fprintln(out, 
 		pow2_6(3, 2, 10),
 		pow2_6(3, 3, 20),
 	)
@@ -85,45 +106,41 @@ func pow2_7(x, n, lim float64) float64 {
 	if v := math.Pow(x, n); v < lim {
 		return v
 	} else {
-		fmt.Printf("%g >= %g\n", v, lim)
+		fmt.Fprintf(out, "%g >= %g\n", v, lim)
 		// can't use v here, though
 	}
 	return lim
 }
 
 func Practice2_7() {
-	fmt.Println(
+	fmt.Fprintln(out, 
 		pow2_7(3, 2, 10),
 		pow2_7(3, 3, 20),
 	)
 }
 
+// Newton's method를 10번까지 반복하면서 매번 z를 출력한다.
+// 실제 계산은 sqrt.go의 Sqrt가 하고, 여기서는 WithTrace로 과정만 보여준다.
 func sqrt2_8(x float64) float64 {
-	z := 1.0
-	for i := 0; i < 10; i++ {
-		z -= (z*z - x) / (2*z)
-		fmt.Println(z)
-	}
+	z, _ := Sqrt(x, WithGuess(1), WithMaxIter(10), WithTrace(func(i int, z float64) {
+		fmt.Fprintln(out, z)
+	}))
 	return z
 }
 
-
+// ImprovedSqrt는 반복 횟수 제한 없이 돌다가 음수나 NaN에서 끝나지 않았으므로
+// Sqrt를 그대로 쓰고, 음수이면 math.Sqrt처럼 NaN을 돌려준다.
 func ImprovedSqrt(x float64) float64 {
-	z := 1.0
-	epsilon := 1e-10 // very small value to check for changes
-	for {
-		nextZ := z - (z*z-x)/(2*z)
-		if math.Abs(nextZ-z) < epsilon {
-			break
-		}
-		z = nextZ
+	z, err := Sqrt(x)
+	if err != nil {
+		return math.NaN()
 	}
 	return z
 }
 
 func Practice2_8() {
-	fmt.Println(sqrt2_8(2))
-	fmt.Println(ImprovedSqrt(2))
+	fmt.Fprintln(out, sqrt2_8(2))
+	fmt.Fprintln(out, ImprovedSqrt(2))
 }
 
 // Go only runs the selected case, not all the cases that follow.
@@ -131,66 +148,78 @@ func Practice2_8() {
 // Another important difference is that Go's switch cases need not be constants,
 // and the values involved need not be integers.
 
+// golden 파일이 OS마다 달라지지 않도록 golden 비교 시에는 고정값으로 바꿔 끼운다
+var goos = runtime.GOOS
+
 func Practice2_9() {
-	fmt.Print("Go runs on ")
-	switch os := runtime.GOOS; os {
+	fmt.Fprint(out, "Go runs on ")
+	switch os := goos; os {
 	case "darwin":
-		fmt.Println("OS X.")
+		fmt.Fprintln(out, "OS X.")
 	case "linux" :
-		fmt.Println("Linux.")
+		fmt.Fprintln(out, "Linux.")
 	default:
 		// freebsd, openbsd, plan9, windows...
-		fmt.Printf("%s. \n", os)
+		fmt.Fprintf(out, "%s. \n", os)
 	}
 }
 
-func Practice2_10() {
-	fmt.Println("When's Saturday?")
-	today := time.Now().Weekday()
+// Saturday2_10 answers "When's Saturday?" relative to the day c reports.
+func Saturday2_10(c Clock) string {
+	today := c.Now().Weekday()
 	switch time.Saturday {
 	case today + 0: // today + 0 == time.Saturday
-		fmt.Println("Today!")
+		return "Today!"
 	case today + 1:
-		fmt.Println("Tomorrow.")
+		return "Tomorrow."
 	case today + 2:
-		fmt.Println("In two days.")
+		return "In two days."
 	default:
-		fmt.Println("Too far away.")
+		return "Too far away."
 	}
 }
 
+func Practice2_10() {
+	fmt.Fprintln(out, "When's Saturday?")
+	fmt.Fprintln(out, Saturday2_10(clock))
+}
+
 // Switch without a condition is the same as switch true.
-func Practice2_11() {
-	t:=time.Now()
+func Greeting2_11(c Clock) string {
+	t:=c.Now()
 
 	switch {
 	case t.Hour() < 12:
-		fmt.Println("Good morning.")
+		return "Good morning."
 	case t.Hour() < 17:
-		fmt.Println("Good afternoon.")
+		return "Good afternoon."
 	default:
-		fmt.Println("Good evening.")
+		return "Good evening."
 	}
 }
 
+func Practice2_11() {
+	fmt.Fprintln(out, Greeting2_11(clock))
+}
+
 // A defer statement defers the execution of a function
 // until the surrounding function returns.
 // The deferred call's arguments are evaluated immediately,
 // but the function call is not executed until the surrounding function returns.
 
 func Practice2_12() {
-	defer fmt.Println("world")
-	fmt.Println("hello")
+	defer fmt.Fprintln(out, "world")
+	fmt.Fprintln(out, "hello")
 }
 
 // Deferred function calls are pushed onto a stack. When a function returns,
 // its deferred calls are executed in last-in-first-out order.
 func Practice2_13() {
-	fmt.Println("counting")
+	fmt.Fprintln(out, "counting")
 
 	for i := 0; i < 10; i++ {
-		defer fmt.Println(i)
+		defer fmt.Fprintln(out, i)
 	}
 
-	fmt.Println("done")
+	fmt.Fprintln(out, "done")
 }
@@ -4,8 +4,47 @@ import (
 	"fmt"
 	"math"
 	"strings"
+
+	"go-study/my_practice/fib"
+	"go-study/my_practice/geo"
+	"go-study/my_practice/geometry"
+	"go-study/my_practice/pic"
+	"go-study/my_practice/seq"
+	"go-study/my_practice/solver"
+	"go-study/my_practice/stats"
+	"go-study/my_practice/tictactoe"
+	"go-study/my_practice/wordcount"
 )
 
+func init() {
+	Register(Exercise{"3_1", 3, "Pointers", Practice3_1})
+	Register(Exercise{"3_2", 3, "Structs", Practice3_2})
+	Register(Exercise{"3_3", 3, "Struct Fields", Practice3_3})
+	Register(Exercise{"3_4", 3, "Pointers to structs", Practice3_4})
+	Register(Exercise{"3_5", 3, "Struct Literals", Practice3_5})
+	Register(Exercise{"3_6", 3, "Arrays", Practice3_6})
+	Register(Exercise{"3_7", 3, "Slices", Practice3_7})
+	Register(Exercise{"3_8", 3, "Slices are like references to arrays", Practice3_8})
+	Register(Exercise{"3_9", 3, "Slice literals", Practice3_9})
+	Register(Exercise{"3_10", 3, "Slice defaults", Practice3_10})
+	Register(Exercise{"3_11", 3, "Slice length and capacity", Practice3_11})
+	Register(Exercise{"3_12", 3, "Nil slices", Practice3_12})
+	Register(Exercise{"3_13", 3, "Creating a slice with make", Practice3_13})
+	Register(Exercise{"3_14", 3, "Slices of slices", Practice3_14})
+	Register(Exercise{"3_15", 3, "Appending to a slice", Practice3_15})
+	Register(Exercise{"3_16", 3, "Range", Practice3_16})
+	Register(Exercise{"3_17", 3, "Range continued", Practice3_17})
+	Register(Exercise{"3_18", 3, "Exercise: Slices", Practice3_18})
+	Register(Exercise{"3_19", 3, "Maps", Practice3_19})
+	Register(Exercise{"3_20", 3, "Map literals", Practice3_20})
+	Register(Exercise{"3_21", 3, "Map literals continued", Practice3_21})
+	Register(Exercise{"3_22", 3, "Mutating Maps", Practice3_22})
+	Register(Exercise{"3_23", 3, "Exercise: Maps", Practice3_23})
+	Register(Exercise{"3_24", 3, "Function values", Practice3_24})
+	Register(Exercise{"3_25", 3, "Function closures", Practice3_25})
+	Register(Exercise{"3_26", 3, "Exercise: Fibonacci closure", Practice3_26})
+}
+
 // Pointers
 
 // Go has pointers. A pointer holds the memory address of a value.
@@ -32,18 +71,18 @@ func Practice3_1() {
 
 	// Below: READ i through the pointer p
 	// pointer를 통해 실제 값을 읽으려면 *p라고 쓴다
-	fmt.Println(*p)  // 42
+	fmt.Fprintln(out, *p)  // 42
 
 	// Below: SET i through the pointer p
 	*p = 21
-	fmt.Println(i) // 21
+	fmt.Fprintln(out, i) // 21
 
 	 // p는 j의 pointer
 	p = &j
 
 	// Below: DIVIDE j through the pointer
 	*p = *p / 37   // j = j / 37
-	fmt.Println(j) // 새로 set한 j = 2701 / 37 = 73
+	fmt.Fprintln(out, j) // 새로 set한 j = 2701 / 37 = 73
 }
 
 // Structs (구조체)
@@ -55,7 +94,10 @@ type Vertex_1 struct {
 	// X, Y int라고 해도 동일함
 }
 func Practice3_2() {
-	fmt.Println(Vertex_1{1, 2})
+	fmt.Fprintln(out, Vertex_1{1, 2})
+	// geometry.Vec2[int]는 같은 구조체에 연산을 붙인 것
+	v, w := geometry.Vec2[int]{X: 1, Y: 2}, geometry.Vec2[int]{X: 3, Y: -4}
+	fmt.Fprintln(out, v, v.Add(w), v.Dot(w), v.Cross(w)) // (1, 2) (4, -2) -5 -10
 }
 
 // Struct fields are accessed using a dot.
@@ -63,7 +105,7 @@ func Practice3_2() {
 func Practice3_3() {
 	v := Vertex_1{1, 2}
 	v.X = 4
-	fmt.Println(v)
+	fmt.Fprintln(out, v)
 }
 
 // Pointers to structs
@@ -75,7 +117,7 @@ func Practice3_4() {
 	v := Vertex_1{1, 2}
 	p := &v
 	p.X = 1e9
-	fmt.Println(v)
+	fmt.Fprintln(out, v)
 }
 
 // Struct Literals
@@ -91,8 +133,8 @@ func Practice3_5() {
 		v3 = Vertex_1{}       // X: 0 and Y: 0
 		p  = &Vertex_1{10, 20}  // has type *Vertex
 	)
-	fmt.Println(v1, p, v2, v3)
-	fmt.Println(p.X) // 10
+	fmt.Fprintln(out, v1, p, v2, v3)
+	fmt.Fprintln(out, p.X) // 10
 }
 
 // Arrays
@@ -101,19 +143,19 @@ func Practice3_6() {
 	// Array의 length는 type에 포함되어 있음.
 	var a [2]string
 	var b [3]float64
-	fmt.Println(a)
-	fmt.Println(b)
+	fmt.Fprintln(out, a)
+	fmt.Fprintln(out, b)
 	a[0] = "Hello"
 	a[1] = "World"
 
-	fmt.Println(a[0], a[1])
-	fmt.Println(a)
+	fmt.Fprintln(out, a[0], a[1])
+	fmt.Fprintln(out, a)
 
 	// 아래는 Go의 Array literal syntax
 	primes := [6]int{2, 3, 5, 7, 11, 13} // **Initialized with values**
-	fmt.Println(primes) // [2 3 5 7 11 13]
+	fmt.Fprintln(out, primes) // [2 3 5 7 11 13]
 	var y [5]int = [5]int{10, 20, 30} // Partial assignment
-	fmt.Println(y) // [10 20 30 0 0]
+	fmt.Fprintln(out, y) // [10 20 30 0 0]
 }
 
 // Slices
@@ -122,7 +164,7 @@ func Practice3_7() {
 
 	//타입 []T: T타입의 값을 가진 슬라이스
 	var s []int = primes[1:4]
-	fmt.Println(s) // [3 5 7]
+	fmt.Fprintln(out, s) // [3 5 7]
 }
 
 // Slices are like references to arrays
@@ -137,15 +179,15 @@ func Practice3_8() {
 		"George",
 		"Ringo",
 	}
-	fmt.Println(names)
+	fmt.Fprintln(out, names)
 
 	a := names[0:2]
 	b := names[1:3]
-	fmt.Println(a, b)
+	fmt.Fprintln(out, a, b)
 
 	b[0] = "XXX" // 슬라이스의 element를 바꾸면 원본 array의 해당 element가 바뀐다
-	fmt.Println(a, b)
-	fmt.Println(names) // [John XXX George Ringo]
+	fmt.Fprintln(out, a, b)
+	fmt.Fprintln(out, names) // [John XXX George Ringo]
 }
 
 // Slice literals
@@ -157,10 +199,10 @@ func Practice3_8() {
 // ** 그 array를 reference하는 슬라이스를 build
 func Practice3_9() {
 	q := []int{2, 3, 5, 7, 11, 13} // 이때 array의 length가 6으로 고정됨
-	fmt.Println(q)
+	fmt.Fprintln(out, q)
 
 	r := []bool{true, false, true, true, false, true}
-	fmt.Println(r)
+	fmt.Fprintln(out, r)
 
 	s := []struct {
 		i int
@@ -173,7 +215,7 @@ func Practice3_9() {
 		{11, false},
 		{13, true},
 	}
-	fmt.Println(s)
+	fmt.Fprintln(out, s)
 	
 }
 
@@ -181,13 +223,13 @@ func Practice3_9() {
 func Practice3_10() {
 	s := []int{2, 3, 5, 7, 11, 13}
 	s = s[:]
-	fmt.Println(s)
+	fmt.Fprintln(out, s)
 	s = s[1:4]
-	fmt.Println(s)
+	fmt.Fprintln(out, s)
 	s = s[:2]
-	fmt.Println(s)
+	fmt.Fprintln(out, s)
 	s = s[1:]
-	fmt.Println(s)
+	fmt.Fprintln(out, s)
 }
 
 // Slice length and capacity
@@ -222,15 +264,15 @@ func Practice3_11() {
 // fyi: Forward declaration
 // (같은 패키지 내에서는 선언하기 전에 사용할 수 있다)
 func printSlice3_11(s []int) {
-	fmt.Printf("len=%d cap=%d %v\n", len(s), cap(s), s)
+	fmt.Fprintf(out, "len=%d cap=%d %v\n", len(s), cap(s), s)
 }
 
 // Nil slices
 func Practice3_12() {
 	var s []int
-	fmt.Println(s, len(s), cap(s)) // [] 0 0
+	fmt.Fprintln(out, s, len(s), cap(s)) // [] 0 0
 	if s == nil {
-		fmt.Println("nil!")
+		fmt.Fprintln(out, "nil!")
 	}
 	// s[0] = 3 // 이 코드를 실행하면 에러 발생
 }
@@ -259,7 +301,7 @@ func Practice3_13() {
 }
 
 func printSlice3_13(s string, x []int) {
-	fmt.Printf("%s len=%d cap=%d %v\n",
+	fmt.Fprintf(out, "%s len=%d cap=%d %v\n",
 		s, len(x), cap(x), x)
 }
 
@@ -283,8 +325,21 @@ func Practice3_14() {
 	board[0][2] = "X"
 
 	for i := 0; i < len(board); i++ {
-		fmt.Printf("%s\n", strings.Join(board[i], " "))
+		fmt.Fprintf(out, "%s\n", strings.Join(board[i], " "))
 	}	
+
+	// tictactoe.Board는 같은 수를 두면서 차례와 빈칸을 확인하고, 누가 이겼는지도 안다
+	b, _ := tictactoe.New(3, 3)
+	// board[0][0]은 a1, board[1][2]는 c2: 열은 글자, 행은 1부터 센다
+	for _, s := range []string{"a1", "c3", "c2", "a2", "c1", "a1"} { // 마지막 a1에는 이미 X가 있다
+		m, _ := tictactoe.ParseMove(s)
+		if err := b.Play(m); err != nil {
+			fmt.Fprintln(out, err)
+		}
+	}
+	ai, _ := tictactoe.NewAI()
+	r, _ := ai.Best(b)
+	fmt.Fprintln(out, b.State(), "-", b.Turn(), "should play", r)
 }
 
 // Appending to a slice
@@ -313,7 +368,7 @@ func Practice3_15() {
 }
 
 func printSlice3_15(s []int) {
-	fmt.Printf("len=%d cap=%d %v\n", len(s), cap(s), s)
+	fmt.Fprintf(out, "len=%d cap=%d %v\n", len(s), cap(s), s)
 }
 
 // ** Range
@@ -323,7 +378,7 @@ func printSlice3_15(s []int) {
 func Practice3_16() {
 	var pow = []int{1, 2, 4, 8, 16, 32, 64, 128} // pow는 slice
 	for i, v := range pow {
-		fmt.Printf("2**%d = %d\n", i, v)
+		fmt.Fprintf(out, "2**%d = %d\n", i, v)
 	}
 }
 
@@ -335,7 +390,7 @@ func Practice3_17() {
 	}
 	// skipping index by assigning to _
 	for _, value := range pow {
-		fmt.Printf("%d\n", value)
+		fmt.Fprintf(out, "%d\n", value)
 	}
 }
 
@@ -343,20 +398,42 @@ func Practice3_17() {
 // 각 element는 dx의 슬라이스
 
 
-func Pic3_18(dx, dy int) [][]uint8 {
-	picture := make([][]uint8, dy) // 2차원 배열
-	for y := 0; y < dy; y++ {
-		row := make([]uint8, dx)
-		for x := 0; x < dx; x++ {
-			row[x] = uint8((x + y) / 2) // x*y 혹은 x^y도 추천
+// 픽셀 값을 정하는 공식. tour에서 추천하는 세 가지를 이름으로 고를 수 있게 둔다.
+var PicFormulas3_18 = map[string]func(x, y int) uint8{
+	"(x+y)/2": func(x, y int) uint8 { return uint8((x + y) / 2) },
+	"x*y":     func(x, y int) uint8 { return uint8(x * y) },
+	"x^y":     func(x, y int) uint8 { return uint8(x ^ y) },
+}
+
+// PicFunc3_18 returns a generator like Pic3_18 that sets pixel (x, y) to formula(x, y).
+func PicFunc3_18(formula func(x, y int) uint8) func(dx, dy int) [][]uint8 {
+	return func(dx, dy int) [][]uint8 {
+		picture := make([][]uint8, dy) // 2차원 배열
+		for y := 0; y < dy; y++ {
+			row := make([]uint8, dx)
+			for x := 0; x < dx; x++ {
+				row[x] = formula(x, y)
+			}
+			picture[y] = row
 		}
-		picture[y] = row
+		return picture
 	}
-	return picture
+}
+
+func Pic3_18(dx, dy int) [][]uint8 {
+	return PicFunc3_18(PicFormulas3_18["(x+y)/2"])(dx, dy) // x*y 혹은 x^y도 추천
 }
 
 func Practice3_18() {
-	// pic.Show(Pic3_18)
+	// tour에서는 pic.Show(Pic3_18)로 브라우저에 그리지만,
+	// 여기서는 로컬 pic 패키지로 터미널에 미리보기를 그린다.
+	// PNG나 IMAGE: 출력은 `my_practice pic` 명령으로 만든다.
+	m, err := pic.FromFunc(Pic3_18, pic.Size, pic.Size)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		return
+	}
+	pic.PreviewPlain(out, m, 32)
 }
 
 // Maps
@@ -378,7 +455,7 @@ func Practice3_19() {
 	m3_19["Bell Labs"] = Vertex3_19{
 		40.68433, -74.39967,
 	}
-	fmt.Println(m3_19["Bell Labs"])
+	fmt.Fprintln(out, m3_19["Bell Labs"])
 }
 
 // Map literals
@@ -393,7 +470,7 @@ var m3_20 = map[string]Vertex3_19 {
 	},
 }
 func Practice3_20() {
-	fmt.Println(m3_20)
+	fmt.Fprintln(out, m3_20)
 }
 
 
@@ -405,28 +482,40 @@ var m3_21 = map[string]Vertex3_19 {
 }
 
 func Practice3_21() {
-	fmt.Println(m3_21)
+	fmt.Fprintln(out, m3_21)
+	// Vertex3_19는 geo.LatLng와 필드가 같아서 그대로 변환해 거리를 잴 수 있다
+	bell, google := geo.LatLng(m3_21["Bell Labs"]), geo.LatLng(m3_21["Google"])
+	fmt.Fprintf(out, "%v → %v: %.1f km, bearing %.1f°\n", bell, google, bell.Distance(google), bell.Bearing(google))
+	// map은 이름으로만 찾을 수 있다. geo.Index에 넣으면 어떤 위치에서 가장 가까운 곳을 찾을 수 있다
+	ix := geo.NewIndex()
+	for name, v := range m3_21 {
+		ix.Insert(name, geo.LatLng(v))
+	}
+	sf := geo.LatLng{Lat: 37.7749, Long: -122.4194}
+	for _, n := range ix.Nearest(sf, 2) {
+		fmt.Fprintf(out, "from San Francisco: %s %.1f km\n", n.Name, n.Km)
+	}
 }
 
 func Practice3_22() {
 	m := make(map[string]int)
 
 	m["Answer"] = 42 // Insert or update an element in map `m`
-	fmt.Println("The value:", m["Answer"])
+	fmt.Fprintln(out, "The value:", m["Answer"])
 
 	m["Answer"] = 48
-	fmt.Println("The value:", m["Answer"])
+	fmt.Fprintln(out, "The value:", m["Answer"])
 	
 	m["Lucky"] = 7
-	fmt.Println("The value:", m["Lucky"])
+	fmt.Fprintln(out, "The value:", m["Lucky"])
 
 	delete(m, "Answer") // Delete an element
-	fmt.Println("The value:", m["Answer"])
+	fmt.Fprintln(out, "The value:", m["Answer"])
 
 	v, ok := m["Answer"] // Test that a key is present with a two-value assignment
 	val, presence := m["Lucky"]
-	fmt.Println("The value:", v, "Present?", ok) 
-	fmt.Println("The value:", val, "Present?", presence)
+	fmt.Fprintln(out, "The value:", v, "Present?", ok) 
+	fmt.Fprintln(out, "The value:", val, "Present?", presence)
 	// In Go, when you use a `short variable declaration`,
 	// the second variable (`ok` or `presence`) is of type boolean
 	// that indicates whether the key exists in the map
@@ -443,7 +532,11 @@ func WordCount3_23(s string) map[string]int {
 }
 
 func Practice3_23() {
-	fmt.Println(WordCount3_23(("I ate a donut. Then I ate another donut.")))
+	fmt.Fprintln(out, WordCount3_23(("I ate a donut. Then I ate another donut.")))
+
+	// 두 단어씩 묶어 세면 어떤 단어 뒤에 어떤 단어가 오는지 보인다
+	bigrams, _ := wordcount.CountNGrams(strings.NewReader("I ate a donut. Then I ate another donut."), 2)
+	fmt.Fprintln(out, bigrams.Counts())
 }
 
 // Function values
@@ -456,9 +549,12 @@ func Practice3_24() {
 	hypot := func(x, y float64) float64 {
 		return math.Sqrt(x*x + y*y)
 	}
-	fmt.Println(hypot(5, 12)) // 13
-	fmt.Println(compute(hypot)) // 5
-	fmt.Println(compute(math.Pow)) // 
+	fmt.Fprintln(out, hypot(5, 12)) // 13
+	fmt.Fprintln(out, compute(hypot)) // 5
+	fmt.Fprintln(out, compute(math.Pow)) // 
+	// 함수를 값으로 넘기면 방정식도 풀 수 있다: hypot(x, 12) = 13이 되는 x
+	r, err := solver.Solve(solver.Brent, func(x float64) float64 { return hypot(x, 12) - 13 }, 0, 13)
+	fmt.Fprintln(out, r, err)
 }
 
 func adder() func(int) int {
@@ -476,11 +572,16 @@ func adder() func(int) int {
 func Practice3_25() {
 	pos, neg := adder(), adder()
 	for i :=0 ; i < 10; i++ {
-		fmt.Println(
+		fmt.Fprintln(out, 
 			pos(i),
 			neg(-2*i),
 		)
 	}
+	// 같은 모양으로 합계 말고도 평균, 표준편차, 최솟값, 최댓값까지 모은다
+	posStats, negStats := stats.Running(), stats.Running()
+	for i := 0; i < 10; i++ {
+		fmt.Fprintln(out, posStats(float64(i)), "|", negStats(float64(-2*i)))
+	}
 }
 
 func fibonacci() func() int {
@@ -495,6 +596,13 @@ func fibonacci() func() int {
 func Practice3_26() {
 	f := fibonacci()
 	for i :=0; i < 10; i++ {
-		fmt.Println(f())
+		fmt.Fprintln(out, f())
 	}
+
+	// 같은 closure를 seq.Seq로 감싸면 Take, Filter 같은 조합을 쓸 수 있다
+	fmt.Fprintln(out, seq.Collect(seq.Take(seq.FromFunc(fibonacci()), 10)))
+	even := seq.Filter(seq.Fibonacci(), func(n int) bool { return n%2 == 0 })
+	fmt.Fprintln(out, "even:", seq.Collect(seq.Take(even, 10)))
+	// int는 F(92)에서 끝난다. 더 큰 n은 fib.Big으로 바로 구한다.
+	fmt.Fprintln(out, "F(100) =", fib.Big(100))
 }
\ No newline at end of file
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+
+	"go-study/my_practice/fib"
+)
+
+func TestFibonacci(t *testing.T) {
+	f := fibonacci()
+	for n := 0; n <= 92; n++ {
+		if got, want := f(), fib.Big(n); int64(got) != want.Int64() {
+			t.Fatalf("fibonacci() call %d = %d, want %v", n+1, got, want)
+		}
+	}
+}
+
+// Practice3_26의 fibonacci() closure로 n번째 값을 얻으려면 n번 불러야 한다.
+// closure는 F(92)까지만 int에 들어가므로 n = 90에서 fib.BenchmarkBig과 비교한다.
+func BenchmarkFibonacci(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		f := fibonacci()
+		for j := 0; j < 90; j++ {
+			f()
+		}
+	}
+}
@@ -2,12 +2,48 @@ package utils
 
 import (
 	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
 	"io"
 	"math"
+	"sort"
 	"strings"
 	"time"
+
+	"go-study/my_practice/geometry"
+	"go-study/my_practice/pic"
+	"go-study/my_practice/readercheck"
 )
 
+func init() {
+	Register(Exercise{"4_1", 4, "Methods", Practice4_1})
+	Register(Exercise{"4_2", 4, "Methods are functions", Practice4_2})
+	Register(Exercise{"4_3", 4, "Methods continued", Practice4_3})
+	Register(Exercise{"4_4", 4, "Pointer receivers", Practice4_4})
+	Register(Exercise{"4_5", 4, "Pointers and functions", Practice4_5})
+	Register(Exercise{"4_6", 4, "Methods and pointer indirection", Practice4_6})
+	Register(Exercise{"4_7", 4, "Methods and pointer indirection (2)", Practice4_7})
+	Register(Exercise{"4_8", 4, "Choosing a value or pointer receiver", Practice4_8})
+	Register(Exercise{"4_9", 4, "Interfaces", Practice4_9})
+	Register(Exercise{"4_10", 4, "Interfaces are implemented implicitly", Practice4_10})
+	Register(Exercise{"4_11", 4, "Interface values", Practice4_11})
+	Register(Exercise{"4_12", 4, "Interface values with nil underlying values", Practice4_12})
+	Register(Exercise{"4_13", 4, "Nil interface values", Practice4_13})
+	Register(Exercise{"4_14", 4, "The empty interface", Practice4_14})
+	Register(Exercise{"4_15", 4, "Type assertions", Practice4_15})
+	Register(Exercise{"4_16", 4, "Type switches", Practice4_16})
+	Register(Exercise{"4_17", 4, "Stringers", Practice4_17})
+	Register(Exercise{"4_18", 4, "Exercise: Stringers", Practice4_18})
+	Register(Exercise{"4_19", 4, "Errors", Practice4_19})
+	Register(Exercise{"4_20", 4, "Exercise: Errors", Practice4_20})
+	Register(Exercise{"4_21", 4, "Readers", Practice4_21})
+	Register(Exercise{"4_22", 4, "Exercise: Readers", Practice4_22})
+	Register(Exercise{"4_23", 4, "Exercise: rot13Reader", Practice4_23})
+	Register(Exercise{"4_24", 4, "Images", Practice4_24})
+	Register(Exercise{"4_25", 4, "Exercise: Images", Practice4_25})
+}
+
 // Go does not have classes. However, you can define methods on types.
 // A method is a function with a special receiver argument
 // The receiver appears in its own argument list btw the func keyword and the method name.
@@ -25,7 +61,7 @@ func (v Vertex) Abs() float64 {
 // This means that `Abs` is a method specifically designed to work with `Vertex` values.
 func Practice4_1() {
 	v := Vertex{3, 4}
-	fmt.Println(v.Abs())
+	fmt.Fprintln(out, v.Abs())
 }
 
 // Methods are functions
@@ -39,7 +75,7 @@ func AbsFunc(v Vertex) float64 {
 
 func Practice4_2() {
 	v := Vertex{3, 4}
-	fmt.Println(AbsFunc(v))
+	fmt.Fprintln(out, AbsFunc(v))
 }
 
 // You can declare a method on non-struct types, too.
@@ -61,7 +97,7 @@ func (f MyFloat) Abs() float64 {
 
 func Practice4_3() {
 	f := MyFloat(-math.Sqrt2)
-	fmt.Println(f.Abs())
+	fmt.Fprintln(out, f.Abs())
 }
 
 // ** Pointer recievers
@@ -95,6 +131,12 @@ func (v Vertex) Scale2(f float64) { // value receiver
 	v.Y = v.Y * f
 }
 
+// Scale은 x, y를 같은 비율로만 늘린다. 이동, 회전, 축마다 다른 비율, 기울이기, 뒤집기는
+// geometry.Affine 행렬로 만들어서 넘긴다. Scale처럼 pointer receiver라서 v 자체가 바뀐다.
+func (v *Vertex) Transform(m geometry.Affine) {
+	v.X, v.Y = m.ApplyXY(v.X, v.Y)
+}
+
 func Practice4_4() {
 	v := Vertex{3, 4}
 	v2 := Vertex{3, 4}
@@ -102,8 +144,8 @@ func Practice4_4() {
 	// 아래에서 v.Scale2(10)을 호출할 때 v2값의 복사본을 만든 후 넘김
 	// 따라서 Scale2 method에서 어떤 변화를 줘도 원본 v에는 영향이 없음
 	v2.Scale2(10)
-	fmt.Println(v.Abs())
-	fmt.Println(v2.Abs())
+	fmt.Fprintln(out, v.Abs())
+	fmt.Fprintln(out, v2.Abs())
 }
 
 // Pointers and functions
@@ -128,9 +170,9 @@ func Practice4_5() {
 	v := Vertex{3, 4}
 	// ScaleFunc(v, 10) // Complile Error
 	ScaleFuncVal(v, 10)
-	fmt.Println(AbsFunc(v))
+	fmt.Fprintln(out, AbsFunc(v))
 	ScaleFunc(&v, 10)
-	fmt.Println(AbsFunc(v))
+	fmt.Fprintln(out, AbsFunc(v))
 }
 
 // Methods and pointer indirection
@@ -147,7 +189,7 @@ func Practice4_6() {
 	p.Scale(3) // {12, 9}
 	ScaleFunc(p, 8) // {96, 72}
 
-	fmt.Println(v, p) // v는 포인터가 아니고 p는 포인터임
+	fmt.Fprintln(out, v, p) // v는 포인터가 아니고 p는 포인터임
 }
 
 
@@ -157,12 +199,12 @@ func Practice4_6() {
 
 func Practice4_7() {
 	v := Vertex{3, 4}
-	fmt.Println(v.Abs())
-	fmt.Println(AbsFunc(v))
+	fmt.Fprintln(out, v.Abs())
+	fmt.Fprintln(out, AbsFunc(v))
 
 	p := &Vertex{4,3}
-	fmt.Println(p.Abs())
-	fmt.Println(AbsFunc(*p))
+	fmt.Fprintln(out, p.Abs())
+	fmt.Fprintln(out, AbsFunc(*p))
 }
 
 // Choosing a value or pointer receiver
@@ -178,9 +220,11 @@ func Practice4_7() {
 
 func Practice4_8() {
 	v := &Vertex{3, 4}
-	fmt.Printf("Before scaling: %+v, Abs: %v\n", v, v.Abs())
+	fmt.Fprintf(out, "Before scaling: %+v, Abs: %v\n", v, v.Abs())
 	v.Scale(5)
-	fmt.Printf("After scaling: %+v, Abs: %v\n", v, v.Abs())
+	fmt.Fprintf(out, "After scaling: %+v, Abs: %v\n", v, v.Abs())
+	v.Transform(geometry.Scale(1, 2).Then(geometry.Shear(0.5, 0)).Then(geometry.Translate(-5, 0)))
+	fmt.Fprintf(out, "After stretching, shearing and moving: %+v, Abs: %v\n", v, v.Abs())
 }
 
 // Interfaces
@@ -199,9 +243,13 @@ func Practice4_9() {
 	v := Vertex{3, 4}
 
 	a = f   // a MyFloat implements Abser
-	fmt.Println(a.Abs()) // 1.4142135623730951
+	fmt.Fprintln(out, a.Abs()) // 1.4142135623730951
 	a = &v  // a *Vertex implements Abser
-	fmt.Println(a.Abs()) // 5
+	fmt.Fprintln(out, a.Abs()) // 5
+	a = geometry.Vec2[float64]{X: 3, Y: 4} // geometry의 벡터도 Abs가 있으므로 Abser이다
+	fmt.Fprintln(out, a.Abs()) // 5
+	a = geometry.Vec3[int]{X: 1, Y: 2, Z: 2}
+	fmt.Fprintln(out, a.Abs()) // 3
 }
 
 // Interfaces are implemented implicitly
@@ -223,7 +271,7 @@ type T struct {
 // but we don't need to explicitly declare that it does so.
 
 func (t T) M() {
-	fmt.Println(t.S)
+	fmt.Fprintln(out, t.S)
 }
 
 func Practice4_10() {
@@ -242,13 +290,13 @@ type I2 interface {
 }
 
 func (t *T) M2() {
-	fmt.Println(t.S)
+	fmt.Fprintln(out, t.S)
 }
 
 type F float64
 
 func (f F) M2() {
-	fmt.Println(f)
+	fmt.Fprintln(out, f)
 }
 
 func Practice4_11() {
@@ -263,7 +311,7 @@ func Practice4_11() {
 }
 
 func describe(i I2) {
-	fmt.Printf("(%v, %T)\n", i, i)
+	fmt.Fprintf(out, "(%v, %T)\n", i, i)
 }
 
 
@@ -282,10 +330,10 @@ type I3 interface {
 
 func (t *T) M3() {
 	if t == nil {
-		fmt.Println("<nil>")
+		fmt.Fprintln(out, "<nil>")
 		return
 	}
-	fmt.Println(t.S) // t
+	fmt.Fprintln(out, t.S) // t
 }
 
 func Practice4_12() {
@@ -308,7 +356,7 @@ func Practice4_12() {
 // to indicate which concrete method to call.
 
 func describe3(i I3) {
-	fmt.Printf("(%v, %T)\n", i, i)
+	fmt.Fprintf(out, "(%v, %T)\n", i, i)
 }
 
 // Nil interface values
@@ -332,7 +380,7 @@ func Practice4_13() {
 
 
 func describe4(i interface{}) {
-	fmt.Printf("(%v, %T)\n", i, i)
+	fmt.Fprintf(out, "(%v, %T)\n", i, i)
 }
 
 func Practice4_14() {
@@ -367,16 +415,16 @@ func Practice4_15() {
 	var i interface{} = "hello"
 
 	s := i.(string)
-	fmt.Println(s) // hello
+	fmt.Fprintln(out, s) // hello
 
 	s, ok := i.(string)
-	fmt.Println(s, ok) // hello true ("hello" is the underlying value)
+	fmt.Fprintln(out, s, ok) // hello true ("hello" is the underlying value)
 
 	f, ok := i.(float64)
-	fmt.Println(f, ok) // 0 false (float64 없기 때문에 float64의 zero value를 print)
+	fmt.Fprintln(out, f, ok) // 0 false (float64 없기 때문에 float64의 zero value를 print)
 
 	f = i.(float64) // panic
-	fmt.Println(f)
+	fmt.Fprintln(out, f)
 }
 
 
@@ -389,11 +437,11 @@ func Practice4_15() {
 func do(i interface{}) {
 	switch v := i.(type) {
 	case int:
-		fmt.Printf("Twice %v is %v\n", v, v*2)
+		fmt.Fprintf(out, "Twice %v is %v\n", v, v*2)
 	case string:
-		fmt.Printf("%q is %v bytes long\n", v, len(v))
+		fmt.Fprintf(out, "%q is %v bytes long\n", v, len(v))
 	default:
-		fmt.Printf("I don't know about type %T!\n", v)
+		fmt.Fprintf(out, "I don't know about type %T!\n", v)
 	}
 }
 
@@ -426,7 +474,12 @@ func (p Person) String() string {
 func Practice4_17() {
 	a := Person{"Arthur Dent", 42}	
 	z := Person{"Zaphod Beeblebrox", 9001}
-	fmt.Println(a, z)
+	fmt.Fprintln(out, a, z)
+	// geometry의 도형도 Stringer라서 WKT 문자열로 출력된다.
+	// Vertex와 geometry.Point는 필드가 같으므로 그대로 변환할 수 있다.
+	tri := geometry.Polygon{geometry.Point(Vertex{0, 0}), geometry.Point(Vertex{4, 0}), geometry.Point(Vertex{0, 3})}
+	var m geometry.Measurer = tri
+	fmt.Fprintln(out, tri, m.Area(), m.Perimeter()) // POLYGON ((0 0, 4 0, 0 3, 0 0)) 6 12
 }
 
 type IPAddr [4]byte
@@ -440,8 +493,14 @@ func Practice4_18() {
 		"loopback":  {127, 0, 0, 1},
 		"googleDNS": {8, 8, 8, 8},
 	}
-	for name, ip := range hosts {
-		fmt.Printf("%v: %v\n", name, ip)
+	// map의 range 순서는 매번 달라지므로 key를 정렬해서 출력
+	names := make([]string, 0, len(hosts))
+	for name := range hosts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "%v: %v\n", name, hosts[name])
 	}
 }
 
@@ -470,10 +529,11 @@ func (e *MyError) Error() string {
 }
 
 // Define `run` function, which returns an error.
-func run() error {
+// The time recorded in the error comes from c.
+func run(c Clock) error {
 	// Create an instance of `MyError`
 	return &MyError{
-		time.Now(),
+		c.Now(),
 		"it didn't work",
 	}
 }
@@ -482,8 +542,8 @@ func run() error {
 // When a function returns `nil` as an error, it typically means that no error occurred, and the operation was successful
 // When a function returns non-`nil` error, it means an error did occur, and the error object contains information about what went wrong.
 func Practice4_19() {
-	if err := run(); err != nil {
-		fmt.Println(err)
+	if err := run(clock); err != nil {
+		fmt.Fprintln(out, err)
 	}
 }
 
@@ -505,12 +565,14 @@ func Sqrt4_20(x float64) (float64, error) {
 		// This works because ErrNegativeSqrt is an alias for float64
 		return 0, ErrNegativeSqrt(x)
 	}
-	return 0, nil
+	return Sqrt(x)
 }
 
 func Practice4_20() {
-	fmt.Println(Sqrt4_20(2))
-	fmt.Println(Sqrt4_20(-2))
+	for _, x := range []float64{2, -2} {
+		v, err := Sqrt4_20(x)
+		fmt.Fprintln(out, v, err)
+	}
 }
 
 // Readers
@@ -531,8 +593,8 @@ func Practice4_21() {
 	b := make([]byte, 8)
 	for {
 		n, err := r.Read(b)
-		fmt.Printf("n = %v err = %v b = %v\n", n, err, b)
-		fmt.Printf("b[:n] = %q\n", b[:n])
+		fmt.Fprintf(out, "n = %v err = %v b = %v\n", n, err, b)
+		fmt.Fprintf(out, "b[:n] = %q\n", b[:n])
 		if err == io.EOF {
 			break
 		}
@@ -550,8 +612,32 @@ func (r MyReader4_22) Read(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// badReader4_22는 일부러 규칙을 어기는 reader.
+// 버퍼보다 큰 n을 돌려주고, EOF를 알린 뒤에도 계속 데이터를 준다.
+type badReader4_22 struct {
+	calls int
+}
+
+func (r *badReader4_22) Read(b []byte) (int, error) {
+	r.calls++
+	if r.calls == 3 {
+		return 0, io.EOF
+	}
+	return len(b) + 1, nil
+}
+
+// tour의 reader.Validate 대신 readercheck 패키지로 확인
+func validate4_22(name string, r io.Reader) {
+	if err := readercheck.Validate(r); err != nil {
+		fmt.Fprintf(out, "%s: %v\n", name, err)
+		return
+	}
+	fmt.Fprintf(out, "%s: OK!\n", name)
+}
+
 func Practice4_22() {
-	// reader.Validate(MyReader4_22{})
+	validate4_22("MyReader4_22", MyReader4_22{})
+	validate4_22("badReader4_22", &badReader4_22{})
 }
 
 // Exercise: rot13Reader
@@ -567,18 +653,104 @@ type rot13Reader struct {
 	r io.Reader
 }
 
+// 알파벳만 13칸 밀고 나머지 byte는 그대로 둔다.
+// rot13을 두 번 적용하면 원래 글자로 돌아온다.
+func rot13(b byte) byte {
+	switch {
+	case b >= 'A' && b <= 'Z':
+		return 'A' + (b-'A'+13)%26
+	case b >= 'a' && b <= 'z':
+		return 'a' + (b-'a'+13)%26
+	}
+	return b
+}
+
+// Read reads from the wrapped reader into b and rewrites the n bytes it got.
+// The error is passed through untouched, so io.EOF arrives exactly when the
+// underlying stream ends.
+func (rr *rot13Reader) Read(b []byte) (int, error) {
+	n, err := rr.r.Read(b)
+	for i := 0; i < n; i++ {
+		b[i] = rot13(b[i])
+	}
+	return n, err
+}
+
 func Practice4_23() {
-	// s := strings.NewReader("Lbh penpxrq gur pbqr!")
-	// r := rot13Reader{s}
-	// io.Copy(os.Stdout, &r)
+	s := strings.NewReader("Lbh penpxrq gur pbqr!")
+	r := rot13Reader{s}
+	io.Copy(out, &r)
+	fmt.Fprintln(out)
+
+	validate4_22("rot13Reader", &rot13Reader{strings.NewReader("Lbh penpxrq gur pbqr!")})
 }
 
+// Images
+
+// Package image defines the Image interface:
+// type Image interface {
+//     ColorModel() color.Model
+//     Bounds() Rectangle
+//     At(x, y int) color.Color
+// }
+// Bounds의 Rectangle은 image.Rectangle{image.Point{x0, y0}, image.Point{x1, y1}}와 같이 선언.
+// color.Color와 color.Model도 interface지만, 보통 미리 정의된 color.RGBA와 color.RGBAModel을 쓴다.
+
 func Practice4_24() {
-	
+	m := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	fmt.Fprintln(out, m.Bounds())
+	r, g, b, a := m.At(0, 0).RGBA()
+	fmt.Fprintln(out, r, g, b, a)
+}
+
+// Exercise: Images
+
+// Pic3_18은 [][]uint8 전체를 미리 만들어두지만,
+// Image는 At이 호출될 때마다 Pixel 함수로 그 자리의 값만 계산한다.
+
+// Image implements image.Image on top of a pixel function such as one of
+// PicFormulas3_18. Model selects how a pixel value v is colored:
+// color.GrayModel gives color.Gray{v}, and anything else gives the tour's
+// color.RGBA{v, v, 255, 255}.
+type Image struct {
+	W, H  int
+	Pixel func(x, y int) uint8
+	Model color.Model
+}
+
+func (m Image) ColorModel() color.Model {
+	if m.Model == color.GrayModel {
+		return color.GrayModel
+	}
+	return color.RGBAModel
+}
+
+func (m Image) Bounds() image.Rectangle {
+	return image.Rect(0, 0, m.W, m.H)
+}
+
+func (m Image) At(x, y int) color.Color {
+	if !(image.Point{x, y}.In(m.Bounds())) {
+		return m.ColorModel().Convert(color.Transparent)
+	}
+	v := m.Pixel(x, y)
+	if m.Model == color.GrayModel {
+		return color.Gray{v}
+	}
+	return color.RGBA{v, v, 255, 255}
 }
 
 func Practice4_25() {
-	
+	m := Image{pic.Size, pic.Size, PicFormulas3_18["x^y"], color.RGBAModel}
+	fmt.Fprintln(out, m.Bounds(), m.At(3, 5))
+
+	// image/draw로 두 패턴을 합성: 왼쪽 절반은 (x+y)/2, 오른쪽 절반은 x^y
+	dst := image.NewGray(m.Bounds())
+	left := image.Rect(0, 0, pic.Size/2, pic.Size)
+	draw.Draw(dst, left, Image{pic.Size, pic.Size, PicFormulas3_18["(x+y)/2"], color.GrayModel}, image.Point{}, draw.Src)
+	right := image.Rect(pic.Size/2, 0, pic.Size, pic.Size)
+	draw.Draw(dst, right, Image{pic.Size, pic.Size, PicFormulas3_18["x^y"], color.GrayModel}, right.Min, draw.Src)
+	pic.PreviewPlain(out, dst, 32)
 }
 
 func Practice4_26() {
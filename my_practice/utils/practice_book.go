@@ -1,9 +1,21 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
+
+	"go-study/my_practice/casing"
+	"go-study/my_practice/hangul"
 )
 
+// chapter 0은 tour 밖에서 책으로 공부한 예제
+func init() {
+	Register(Exercise{"0_1", 0, "ToUpper: string concatenation vs strings.Builder", Practice0_1})
+}
+
+// ToUpper1과 ToUpper2는 ASCII a–z만 바꾼다. "straße"의 ß나 그리스 문자까지
+// 제대로 바꾸려면 casing.ToUpper를 쓴다.
+
 func ToUpper1(str string) string {
 	var rst string
 	for _, c := range str {
@@ -16,14 +28,38 @@ func ToUpper1(str string) string {
 	return rst
 }
 
+// UpperASCII maps a–z to A–Z and leaves every other rune alone.
+// It is the per-rune step of ToUpper2, shared with the transform package.
+func UpperASCII(c rune) rune {
+	if c >= 'a' && c <= 'z' {
+		return 'A' + (c - 'a')
+	}
+	return c
+}
+
+// LowerASCII is the inverse of UpperASCII: it maps A–Z to a–z only.
+func LowerASCII(c rune) rune {
+	if c >= 'A' && c <= 'Z' {
+		return 'a' + (c - 'A')
+	}
+	return c
+}
+
 func ToUpper2(str string) string {
 	var builder strings.Builder
 	for _, c := range str {
-		if c >= 'a' && c <= 'z' {
-			builder.WriteRune('A' + (c -'a'))
-		} else {
-			builder.WriteRune(c)
-		}
+		builder.WriteRune(UpperASCII(c))
 	}
 	return builder.String()
-}
\ No newline at end of file
+}
+
+func Practice0_1() {
+	fmt.Fprintln(out, ToUpper1("Hello 월드!"))
+	fmt.Fprintln(out, ToUpper2("Hello 월드!"))
+
+	// ASCII 밖의 글자는 그대로 남는다
+	fmt.Fprintln(out, ToUpper2("straße ΟΔΟΣ"), casing.ToUpper("straße"), casing.ToLower("ΟΔΟΣ"))
+
+	// 한글은 대소문자가 없다. 대신 자모로 나누거나 로마자로 옮길 수 있다.
+	fmt.Fprintln(out, hangul.Romanize("Hello 월드!"), hangul.Choseong("Hello 월드!"))
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Exercise registry
+
+// 각 practice 파일의 init()에서 Register를 호출해 자기 자신을 등록한다.
+// main은 registry만 보고 실행하므로, 새 연습문제를 추가해도 main.go를 고칠 필요가 없다.
+
+// Exercise describes one runnable PracticeN_M function.
+// ID is the "N_M" suffix of the function name, e.g. "3_23".
+type Exercise struct {
+	ID      string
+	Chapter int
+	Title   string
+	Run     func()
+}
+
+var exercises = make(map[string]Exercise)
+
+// 모든 Practice 함수는 fmt.Println 대신 out에 출력한다.
+// 기본값은 stdout이고, golden 비교를 할 때는 buffer로 바꿔 끼운다.
+var out io.Writer = os.Stdout
+
+// SetOutput sets the writer exercises print to.
+func SetOutput(w io.Writer) {
+	out = w
+}
+
+// RunTo runs e with its output sent to w and restores the previous writer
+// afterwards. Practice4_13 and Practice4_15 panic on purpose, so a panic is
+// recovered and returned as an error instead of taking the caller down.
+func (e Exercise) RunTo(w io.Writer) (err error) {
+	prev := out
+	out = w
+	defer func() {
+		out = prev
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	e.Run()
+	return nil
+}
+
+// Register adds e to the registry. It panics on a duplicate or malformed ID,
+// since both are programming mistakes that should fail at startup.
+func Register(e Exercise) {
+	if _, _, err := parseID(e.ID); err != nil {
+		panic(err)
+	}
+	if _, dup := exercises[e.ID]; dup {
+		panic(fmt.Sprintf("utils: exercise %s registered twice", e.ID))
+	}
+	exercises[e.ID] = e
+}
+
+// Lookup returns the exercise registered under id.
+func Lookup(id string) (Exercise, bool) {
+	e, ok := exercises[id]
+	return e, ok
+}
+
+// Exercises returns every registered exercise ordered by chapter, then number.
+func Exercises() []Exercise {
+	list := make([]Exercise, 0, len(exercises))
+	for _, e := range exercises {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return lessID(list[i].ID, list[j].ID)
+	})
+	return list
+}
+
+// Chapter returns the exercises of chapter ch in order.
+func Chapter(ch int) []Exercise {
+	var list []Exercise
+	for _, e := range Exercises() {
+		if e.Chapter == ch {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// parseID splits "3_23" into (3, 23).
+func parseID(id string) (int, int, error) {
+	ch, num, ok := strings.Cut(id, "_")
+	if !ok {
+		return 0, 0, fmt.Errorf("utils: malformed exercise id %q", id)
+	}
+	c, err1 := strconv.Atoi(ch)
+	n, err2 := strconv.Atoi(num)
+	if err1 != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("utils: malformed exercise id %q", id)
+	}
+	return c, n, nil
+}
+
+// "3_9"가 "3_10"보다 앞에 오도록 숫자로 비교
+func lessID(a, b string) bool {
+	ac, an, _ := parseID(a)
+	bc, bn, _ := parseID(b)
+	if ac != bc {
+		return ac < bc
+	}
+	return an < bn
+}
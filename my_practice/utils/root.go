@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Nth roots
+
+// ImprovedSqrt를 n제곱근으로 넓힌 것. 기본은 Halley's method(3차 수렴)이고
+// WithNewton으로 Newton's method를 쓸 수도 있다.
+// Sqrt처럼 x = m * 2^e에서 e를 n의 배수 q*n과 나머지 r로 나눠서
+// (m * 2^r)의 n제곱근만 반복으로 구하고 2^q를 곱한다.
+
+// ErrNegativeRoot is returned for an even root of a negative number,
+// in the style of ErrNegativeSqrt. Use ComplexRoot for those.
+type ErrNegativeRoot struct {
+	X float64
+	N int
+}
+
+func (e ErrNegativeRoot) Error() string {
+	return fmt.Sprintf("cannot take root %d of negative number: %v", e.N, e.X)
+}
+
+// ErrRootDegree is returned when the degree n of a root is less than 1.
+type ErrRootDegree int
+
+func (e ErrRootDegree) Error() string {
+	return fmt.Sprintf("invalid root degree: %d", int(e))
+}
+
+// WithNewton makes Root and Cbrt use Newton's method instead of Halley's.
+// Sqrt always uses Newton's method.
+func WithNewton() Option {
+	return func(o *rootOptions) { o.newton = true }
+}
+
+// Root returns the real nth root of x for n >= 1.
+//
+// Odd roots of negative numbers are negative, e.g. Root(-8, 3) = -2.
+// Even roots of negative numbers return ErrNegativeRoot, except
+// Root(x, 2), which is Sqrt(x) and returns ErrNegativeSqrt.
+// NaN, ±0 and ±Inf are returned unchanged when the root exists.
+func Root(x float64, n int, opts ...Option) (float64, error) {
+	if n < 1 {
+		return 0, ErrRootDegree(n)
+	}
+	if n == 2 {
+		return Sqrt(x, opts...)
+	}
+	o := defaultRootOptions()
+	if err := o.apply(opts); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case math.IsNaN(x) || n == 1:
+		return x, nil
+	case x < 0 && n%2 == 0:
+		return 0, ErrNegativeRoot{x, n}
+	case x == 0 || math.IsInf(x, 0):
+		return x, nil
+	}
+
+	sign := 1.0
+	if x < 0 {
+		sign, x = -1, -x
+	}
+
+	// x = m * 2^(q*n + r), 0 <= r < n
+	m, e := math.Frexp(x)
+	q := e / n
+	if e%n < 0 {
+		q--
+	}
+	r := e - q*n
+
+	// ratio은 z^n / (m * 2^r). z^n은 n이 크면 overflow할 수 있으므로 지수를 따로 들고 계산한다.
+	ratio := func(z float64) float64 {
+		f, fe := powFrexp(z, n)
+		return math.Ldexp(f/m, fe-r)
+	}
+
+	z := math.Exp2((math.Log2(m) + float64(r)) / float64(n))
+	if o.guess != 0 {
+		if g := math.Ldexp(o.guess, -q); g != 0 && !math.IsInf(g, 1) {
+			z = g
+		}
+	}
+	nf := float64(n)
+	for i := 1; i <= o.maxIter; i++ {
+		t := ratio(z)
+		var next float64
+		if o.newton {
+			next = z * ((nf - 1) + 1/t) / nf
+		} else {
+			next = z * ((nf-1)*t + (nf + 1)) / ((nf+1)*t + (nf - 1))
+		}
+		if o.trace != nil {
+			o.trace(i, sign*math.Ldexp(next, q))
+		}
+		if math.Abs(next-z) <= o.tol*next {
+			return sign * math.Ldexp(next, q), nil
+		}
+		z = next
+	}
+	last := sign * math.Ldexp(z, q)
+	return last, &ErrNoConvergence{o.maxIter, last}
+}
+
+// Cbrt returns the real cube root of x; Cbrt(-27) = -3.
+func Cbrt(x float64, opts ...Option) (float64, error) {
+	return Root(x, 3, opts...)
+}
+
+// ComplexRoot returns the principal nth root of x as a complex128, the
+// same branch cmplx.Sqrt and cmplx.Pow use. For negative x that is
+// |x|^(1/n) * e^(iπ/n), so ComplexRoot(-4, 2) = 2i like cmplx.Sqrt(-4),
+// and ComplexRoot(-8, 3) = 1+1.732i rather than the real root -2.
+func ComplexRoot(x float64, n int, opts ...Option) (complex128, error) {
+	if n < 1 {
+		return 0, ErrRootDegree(n)
+	}
+	if x >= 0 || math.IsNaN(x) {
+		r, err := Root(x, n, opts...)
+		return complex(r, 0), err
+	}
+	mag, err := Root(-x, n, opts...)
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 1:
+		// 1제곱근은 x 자신이다. cos(π), sin(π)의 반올림 오차로 허수부가 생기지 않게 한다
+		return complex(x, 0), nil
+	case 2:
+		// cos(π/2)가 정확히 0이 되지 않으므로 따로 처리해서 cmplx.Sqrt와 같게 만든다
+		return complex(0, mag), nil
+	}
+	sin, cos := math.Sincos(math.Pi / float64(n))
+	return complex(mag*cos, mag*sin), nil
+}
+
+// Roots returns all n nth roots of z, starting with the principal one and
+// going counterclockwise: |z|^(1/n) * e^(i(arg z + 2πk)/n) for k = 0..n-1.
+func Roots(z complex128, n int, opts ...Option) ([]complex128, error) {
+	if n < 1 {
+		return nil, ErrRootDegree(n)
+	}
+	mag, err := Root(cmplx.Abs(z), n, opts...)
+	if err != nil {
+		return nil, err
+	}
+	arg := cmplx.Phase(z)
+	roots := make([]complex128, n)
+	for k := range roots {
+		roots[k] = cmplx.Rect(mag, (arg+2*math.Pi*float64(k))/float64(n))
+	}
+	return roots, nil
+}
+
+// powFrexp returns z^n as f * 2^e with f in [0.5, 1), so that large n
+// cannot overflow. It uses exponentiation by squaring.
+func powFrexp(z float64, n int) (float64, int) {
+	f, e := 1.0, 0
+	b, be := math.Frexp(z)
+	for n > 0 {
+		if n&1 == 1 {
+			f, e = renorm(f*b, e+be)
+		}
+		b, be = renorm(b*b, be*2)
+		n >>= 1
+	}
+	return f, e
+}
+
+func renorm(f float64, e int) (float64, int) {
+	fr, fe := math.Frexp(f)
+	return fr, e + fe
+}
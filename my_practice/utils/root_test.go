@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestRoot(t *testing.T) {
+	negZero, inf, nan := math.Copysign(0, -1), math.Inf(1), math.NaN()
+	for _, c := range []struct {
+		x    float64
+		n    int
+		want float64
+		err  error
+	}{
+		{27, 3, 3, nil},
+		{-27, 3, -3, nil},
+		{16, 4, 2, nil},
+		{32, 5, 2, nil},
+		{1000, 3, 10, nil},
+		{2, 1, 2, nil},
+		{0, 3, 0, nil},
+		{negZero, 3, negZero, nil},
+		{-inf, 3, -inf, nil},
+		{inf, 4, inf, nil},
+		{nan, 4, nan, nil},
+		{-16, 4, 0, ErrNegativeRoot{-16, 4}},
+		{-4, 2, 0, ErrNegativeSqrt(-4)},
+		{2, 0, 0, ErrRootDegree(0)},
+		{2, -3, 0, ErrRootDegree(-3)},
+	} {
+		for _, opts := range [][]Option{nil, {WithNewton()}} {
+			got, err := Root(c.x, c.n, opts...)
+			if !same(got, c.want) || err != c.err {
+				t.Errorf("Root(%v, %d) with %d options = %v, %v; want %v, %v", c.x, c.n, len(opts), got, err, c.want, c.err)
+			}
+		}
+	}
+}
+
+// checkRoot reports an error unless r^n is within tol n-ulps of x.
+// math.Pow(x, 1/n)는 1/n이 이미 반올림되어 있어서 큰 x에서는 수백 ulp까지 틀리므로
+// 비교 대상으로 쓸 수 없다. 그래서 r^n을 다시 계산해서 x와의 상대오차를 n*ε 단위로 본다.
+// root가 1 ulp 틀리면 r^n은 대략 n ulp 틀리므로, 1 근처면 정상이다.
+func checkRoot(t *testing.T, x float64, n int, tol float64) {
+	t.Helper()
+	r, err := Root(x, n)
+	if err != nil {
+		t.Errorf("Root(%v, %d): %v", x, n, err)
+		return
+	}
+	// 지수는 따로 빼서 비교해야 MaxFloat64 근처에서도 넘치지 않는다
+	f, e := powFrexp(r, n)
+	fx, ex := math.Frexp(x)
+	if rel := math.Abs(math.Ldexp(f, e-ex)/fx-1) / (float64(n) * 0x1p-53); rel > tol {
+		t.Errorf("Root(%v, %d) = %v, off by %.2f n*ε", x, n, r, rel)
+	}
+}
+
+func TestRootAccuracy(t *testing.T) {
+	checkRoot(t, math.MaxFloat64, 7, 4)
+	checkRoot(t, math.SmallestNonzeroFloat64, 3, 4)
+	checkRoot(t, 10, 2000, 4)
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		x := math.Ldexp(1+rnd.Float64(), rnd.Intn(2000)-1000)
+		if got, _ := Cbrt(x); ulps(got, math.Cbrt(x)) > 2 {
+			t.Errorf("Cbrt(%v) = %v, math.Cbrt %v", x, got, math.Cbrt(x))
+		}
+		checkRoot(t, x, 2+rnd.Intn(15), 4)
+	}
+}
+
+func TestRootHalleyBeatsNewton(t *testing.T) {
+	steps := map[bool]int{}
+	for _, newton := range []bool{false, true} {
+		opts := []Option{WithTrace(func(int, float64) { steps[newton]++ }), WithGuess(1)}
+		if newton {
+			opts = append(opts, WithNewton())
+		}
+		if r, err := Root(1e6, 5, opts...); err != nil || math.Abs(r/math.Pow(10, 1.2)-1) > 1e-15 {
+			t.Errorf("Root(1e6, 5), Newton %v = %v, %v", newton, r, err)
+		}
+	}
+	// Halley은 3차 수렴이므로 먼 출발점에서도 Newton보다 훨씬 적게 돈다
+	if steps[false] >= steps[true] {
+		t.Errorf("from guess 1: Halley took %d steps, Newton %d", steps[false], steps[true])
+	}
+}
+
+func closeTo(got, want complex128) bool {
+	return cmplx.Abs(got-want) <= 1e-14*math.Max(1, cmplx.Abs(want))
+}
+
+func TestComplexRoot(t *testing.T) {
+	// 음수의 짝수 제곱근은 cmplx.Sqrt처럼 실수부가 정확히 0이어야 한다
+	if got, err := ComplexRoot(-4, 2); got != cmplx.Sqrt(-4) || err != nil {
+		t.Errorf("ComplexRoot(-4, 2) = %v, %v; want %v", got, err, cmplx.Sqrt(-4))
+	}
+	// 1제곱근은 x 자신이고 허수부가 없다
+	if got, err := ComplexRoot(-3, 1); got != complex(-3, 0) || err != nil {
+		t.Errorf("ComplexRoot(-3, 1) = %v, %v; want (-3+0i)", got, err)
+	}
+	for _, c := range []struct {
+		x float64
+		n int
+	}{
+		{-8, 3}, {-16, 4}, {9, 2}, {-2, 5}, {0.5, 3},
+	} {
+		got, err := ComplexRoot(c.x, c.n)
+		want := cmplx.Pow(complex(c.x, 0), complex(1/float64(c.n), 0))
+		if err != nil || !closeTo(got, want) {
+			t.Errorf("ComplexRoot(%v, %d) = %v, %v; cmplx.Pow %v", c.x, c.n, got, err, want)
+		}
+	}
+	if _, err := ComplexRoot(-1, 0); err != ErrRootDegree(0) {
+		t.Errorf("ComplexRoot(-1, 0): %v", err)
+	}
+}
+
+func TestRoots(t *testing.T) {
+	// z는 practice1.go의 cmplx.Sqrt(-5 + 12i) = 2+3i
+	if got, _ := Roots(-5+12i, 2); !closeTo(got[0], z) {
+		t.Errorf("Roots(-5+12i, 2)[0] = %v, want %v", got[0], z)
+	}
+	for _, w := range []complex128{-5 + 12i, -1, 8i, 1, 3 - 4i} {
+		for n := 1; n <= 6; n++ {
+			roots, err := Roots(w, n)
+			if err != nil || len(roots) != n {
+				t.Fatalf("Roots(%v, %d) = %v, %v", w, n, roots, err)
+			}
+			if want := cmplx.Pow(w, complex(1/float64(n), 0)); !closeTo(roots[0], want) {
+				t.Errorf("Roots(%v, %d)[0] = %v, want the principal root %v", w, n, roots[0], want)
+			}
+			for k, r := range roots {
+				if p := cmplx.Pow(r, complex(float64(n), 0)); !closeTo(p, w) {
+					t.Errorf("Roots(%v, %d)[%d] = %v, whose power is %v", w, n, k, r, p)
+				}
+			}
+		}
+	}
+	if _, err := Roots(1, 0); err != ErrRootDegree(0) {
+		t.Errorf("Roots(1, 0): %v", err)
+	}
+}
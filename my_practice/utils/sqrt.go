@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+
+	"go-study/my_practice/solver"
+)
+
+// Square root by Newton's method
+
+// Practice2_8의 z -= (z*z - x) / (2*z)를 제대로 된 함수로 만든 것.
+// x를 frac * 2^exp (exp는 짝수)로 나눠서 frac의 제곱근만 Newton으로 구하고
+// 2^(exp/2)를 다시 곱한다. 그래서 아주 크거나 subnormal인 x도 같은 횟수 안에 수렴한다.
+
+// ErrNoConvergence is returned when the iteration limit is reached before
+// successive guesses agree within the tolerance. Value is the last guess.
+// solver.ErrNoConvergence is a different type that carries a whole
+// solver.Result.
+type ErrNoConvergence struct {
+	Iterations int
+	Value      float64
+}
+
+func (e *ErrNoConvergence) Error() string {
+	return fmt.Sprintf("no convergence after %d iterations (last guess %v)", e.Iterations, e.Value)
+}
+
+// ErrInvalidOption is returned when an Option is given a value it cannot use.
+// It is solver.ErrInvalidOption, so one errors.Is check covers both packages.
+var ErrInvalidOption = solver.ErrInvalidOption
+
+// Option configures Sqrt and the root functions in root.go.
+type Option func(*rootOptions)
+
+type rootOptions struct {
+	tol     float64
+	maxIter int
+	guess   float64 // 0 means pick one automatically
+	trace   func(iter int, z float64)
+	newton  bool // Root only
+	err     error
+}
+
+func defaultRootOptions() rootOptions {
+	return rootOptions{tol: 1e-15, maxIter: 100}
+}
+
+func (o *rootOptions) apply(opts []Option) error {
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o.err
+}
+
+// WithTolerance stops the iteration once two successive guesses differ by
+// at most tol relative to the newer one. The default is 1e-15.
+// A tolerance of 0 only stops when a guess repeats exactly.
+func WithTolerance(tol float64) Option {
+	return func(o *rootOptions) {
+		if tol < 0 || math.IsNaN(tol) {
+			o.err = fmt.Errorf("%w: tolerance %v", ErrInvalidOption, tol)
+			return
+		}
+		o.tol = tol
+	}
+}
+
+// WithMaxIter caps the number of Newton steps. The default is 100.
+func WithMaxIter(n int) Option {
+	return func(o *rootOptions) {
+		if n <= 0 {
+			o.err = fmt.Errorf("%w: max iterations %d", ErrInvalidOption, n)
+			return
+		}
+		o.maxIter = n
+	}
+}
+
+// WithGuess sets the starting guess, which must be positive and finite.
+func WithGuess(z float64) Option {
+	return func(o *rootOptions) {
+		if !(z > 0) || math.IsInf(z, 1) {
+			o.err = fmt.Errorf("%w: starting guess %v", ErrInvalidOption, z)
+			return
+		}
+		o.guess = z
+	}
+}
+
+// WithTrace calls f after every Newton step with the step number (from 1)
+// and the new guess.
+func WithTrace(f func(iter int, z float64)) Option {
+	return func(o *rootOptions) { o.trace = f }
+}
+
+// Sqrt returns the square root of x using Newton's method.
+//
+// Special cases follow math.Sqrt, except that negative x (including -Inf)
+// returns 0 and ErrNegativeSqrt(x):
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(NaN) = NaN
+func Sqrt(x float64, opts ...Option) (float64, error) {
+	o := defaultRootOptions()
+	if err := o.apply(opts); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case math.IsNaN(x):
+		return x, nil
+	case x < 0:
+		return 0, ErrNegativeSqrt(x)
+	case x == 0 || math.IsInf(x, 1):
+		return x, nil
+	}
+
+	// x = frac * 2^exp, frac in [0.5, 2), exp even
+	frac, exp := math.Frexp(x)
+	if exp%2 != 0 {
+		frac *= 2
+		exp--
+	}
+	half := exp / 2
+
+	z := 1.0
+	if o.guess != 0 {
+		z = math.Ldexp(o.guess, -half)
+		if z == 0 || math.IsInf(z, 1) {
+			z = 1 // the guess is too far off to even scale; start from the default
+		}
+	}
+	for i := 1; i <= o.maxIter; i++ {
+		// z - (z*z-frac)/(2*z)와 같은 식이지만 z가 커도 z*z가 overflow하지 않는다
+		next := z - (z-frac/z)/2
+		if o.trace != nil {
+			o.trace(i, math.Ldexp(next, half))
+		}
+		if math.Abs(next-z) <= o.tol*next {
+			return math.Ldexp(polish(next, frac), half), nil
+		}
+		z = next
+	}
+	return math.Ldexp(z, half), &ErrNoConvergence{o.maxIter, math.Ldexp(z, half)}
+}
+
+// Newton can settle one ulp away from the correctly rounded root.
+// polish compares z with its two neighbours and keeps the one whose square
+// is closest to frac, computing the residual exactly with FMA.
+func polish(z, frac float64) float64 {
+	best, r := z, math.Abs(math.FMA(z, z, -frac))
+	for _, c := range []float64{math.Nextafter(z, 0), math.Nextafter(z, 2)} {
+		if rc := math.Abs(math.FMA(c, c, -frac)); rc < r {
+			best, r = c, rc
+		}
+	}
+	return best
+}
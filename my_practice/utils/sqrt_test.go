@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"go-study/my_practice/solver"
+)
+
+// same reports whether a and b are the same float64, telling -0 from 0 and
+// treating NaN as equal to itself.
+func same(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b) || math.IsNaN(a) && math.IsNaN(b)
+}
+
+func TestSqrtSpecialCases(t *testing.T) {
+	for _, x := range []float64{
+		math.NaN(), math.Inf(1), 0, math.Copysign(0, -1),
+		math.SmallestNonzeroFloat64, 1e-310, 0.25, 2, 1e300, math.MaxFloat64,
+	} {
+		if got, err := Sqrt(x); !same(got, math.Sqrt(x)) || err != nil {
+			t.Errorf("Sqrt(%v) = %v, %v; want %v", x, got, err, math.Sqrt(x))
+		}
+	}
+	for _, x := range []float64{-2, math.Inf(-1), -math.SmallestNonzeroFloat64} {
+		if got, err := Sqrt(x); got != 0 || err != ErrNegativeSqrt(x) {
+			t.Errorf("Sqrt(%v) = %v, %v; want 0, ErrNegativeSqrt(%v)", x, got, err, x)
+		}
+	}
+	if got, err := Sqrt4_20(-2); got != 0 || err != ErrNegativeSqrt(-2) {
+		t.Errorf("Sqrt4_20(-2) = %v, %v", got, err)
+	}
+	if got, err := Sqrt4_20(2); got != math.Sqrt2 || err != nil {
+		t.Errorf("Sqrt4_20(2) = %v, %v", got, err)
+	}
+}
+
+func TestSqrtOptions(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"WithTolerance(-1)":  WithTolerance(-1),
+		"WithTolerance(NaN)": WithTolerance(math.NaN()),
+		"WithMaxIter(0)":     WithMaxIter(0),
+		"WithGuess(0)":       WithGuess(0),
+	} {
+		if _, err := Sqrt(2, opt); !errors.Is(err, ErrInvalidOption) {
+			t.Errorf("Sqrt(2, %s): %v, want ErrInvalidOption", name, err)
+		}
+	}
+	// solver와 같은 error 값이므로 errors.Is 한 번으로 둘 다 걸러진다
+	if _, err := solver.Solve(solver.Newton, math.Sin, 3, 3, solver.WithMaxIter(0)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("solver.WithMaxIter(0): %v, want ErrInvalidOption", err)
+	}
+
+	_, err := Sqrt(2, WithGuess(1e300), WithMaxIter(3))
+	var nc *ErrNoConvergence
+	if !errors.As(err, &nc) || nc.Iterations != 3 {
+		t.Errorf("Sqrt(2) from 1e300 in 3 steps: %v, want *ErrNoConvergence after 3", err)
+	}
+	if got, err := Sqrt(1e300, WithGuess(1e-300)); got != 1e150 || err != nil {
+		t.Errorf("Sqrt(1e300) from 1e-300 = %v, %v", got, err)
+	}
+
+	var trace []float64
+	got, err := Sqrt(2, WithTrace(func(i int, z float64) {
+		if i != len(trace)+1 {
+			t.Errorf("trace step %d after %d steps", i, len(trace))
+		}
+		trace = append(trace, z)
+	}))
+	if err != nil || len(trace) == 0 || math.Abs(trace[len(trace)-1]-got) > 1e-15 {
+		t.Errorf("Sqrt(2) = %v, %v with trace %v", got, err, trace)
+	}
+}
+
+// ulps returns how many representable float64s lie between two
+// non-negative finite values.
+func ulps(a, b float64) uint64 {
+	x, y := math.Float64bits(a), math.Float64bits(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// Sqrt는 polish로 마지막 ulp까지 맞추므로 math.Sqrt와 정확히 같아야 한다.
+// 지수를 고르게 뽑아서 subnormal부터 MaxFloat64 근처까지 골고루 확인한다.
+func TestSqrtMatchesMath(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 100000
+	failed := 0
+	for i := 0; i < n && failed < 10; i++ {
+		x := math.Ldexp(1+rnd.Float64(), rnd.Intn(2098)-1075)
+		got, err := Sqrt(x)
+		if want := math.Sqrt(x); got != want || err != nil {
+			t.Errorf("Sqrt(%v) = %v, %v; math.Sqrt %v (%d ulp)", x, got, err, want, ulps(got, want))
+			failed++
+		}
+	}
+}
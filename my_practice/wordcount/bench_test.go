@@ -0,0 +1,105 @@
+package wordcount_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"go-study/my_practice/utils"
+	"go-study/my_practice/wordcount"
+)
+
+// utils.WordCount3_23(한 string, 한 goroutine)와 serial/parallel 방식을
+// 같은 corpus로 비교한다. corpus는 Zipf 분포로 뽑은 단어로 만든 8개의 1MB 문서.
+//
+//	go test ./wordcount -run - -bench WordCount
+
+var (
+	corpusOnce sync.Once
+	corpus     [][]byte
+)
+
+func benchCorpus() [][]byte {
+	corpusOnce.Do(func() {
+		rnd := rand.New(rand.NewSource(1))
+		vocab := make([]string, 5000)
+		for i := range vocab {
+			vocab[i] = fmt.Sprintf("word%d", i)
+		}
+		zipf := rand.NewZipf(rnd, 1.1, 1, uint64(len(vocab)-1))
+		for doc := 0; doc < 8; doc++ {
+			var buf bytes.Buffer
+			for buf.Len() < 1<<20 {
+				buf.WriteString(vocab[zipf.Uint64()])
+				if rnd.Intn(12) == 0 {
+					buf.WriteString(".\n")
+				} else {
+					buf.WriteByte(' ')
+				}
+			}
+			corpus = append(corpus, buf.Bytes())
+		}
+	})
+	return corpus
+}
+
+func corpusBytes() int64 {
+	var n int64
+	for _, doc := range benchCorpus() {
+		n += int64(len(doc))
+	}
+	return n
+}
+
+func BenchmarkWordCount(b *testing.B) {
+	docs := benchCorpus()
+
+	b.Run("WordCount3_23", func(b *testing.B) {
+		var sb strings.Builder
+		for _, doc := range docs {
+			sb.Write(doc)
+		}
+		text := sb.String()
+		b.SetBytes(int64(len(text)))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			utils.WordCount3_23(text)
+		}
+	})
+
+	b.Run("serial", func(b *testing.B) {
+		b.SetBytes(corpusBytes())
+		for i := 0; i < b.N; i++ {
+			c := wordcount.New()
+			for _, doc := range docs {
+				if _, err := c.ReadFrom(bytes.NewReader(doc)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	srcs := make([]wordcount.Source, len(docs))
+	for i, doc := range docs {
+		doc := doc
+		srcs[i] = wordcount.Source{
+			Name: fmt.Sprint("doc", i),
+			Open: func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(doc)), nil },
+		}
+	}
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("parallel/%d", workers), func(b *testing.B) {
+			b.SetBytes(corpusBytes())
+			for i := 0; i < b.N; i++ {
+				if _, err := wordcount.CountParallel(context.Background(), srcs, workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package wordcount
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Inverted index
+
+// 단어마다 그 단어가 나오는 문서와 문서 안의 위치 목록(posting list)을 들고 있다.
+// 위치는 문서의 몇 번째 단어인지(0부터)이고, stopword도 자리는 차지한다.
+// 그래서 stopword를 빼고 색인해도 위치는 원문의 단어 순서와 같다.
+
+// Posting lists where one term occurs in one document.
+type Posting struct {
+	Doc       int
+	Positions []int // 오름차순
+}
+
+// DocInfo describes an indexed document.
+type DocInfo struct {
+	Name  string
+	Words int // stopword를 포함한 단어 수
+}
+
+// Index is an in-memory inverted index. The zero value is not usable; use
+// NewIndex or ReadIndex.
+type Index struct {
+	fold  *Counter // 옵션(fold, stopword)만 쓴다
+	docs  []DocInfo
+	terms map[string][]Posting // Doc 오름차순
+}
+
+// NewIndex returns an empty Index. opts decide how words are folded and
+// which are left out, for documents and queries alike.
+func NewIndex(opts ...Option) *Index {
+	return &Index{fold: New(opts...), terms: make(map[string][]Posting)}
+}
+
+// Add indexes the words of r as a new document and returns its number.
+// A document that fails to read is not added.
+func (ix *Index) Add(name string, r io.Reader) (int, error) {
+	doc := len(ix.docs)
+	pos := 0
+	found := make(map[string][]int)
+	_, err := scan(r, func(word string) {
+		if w, ok := ix.fold.word(word); ok {
+			found[w] = append(found[w], pos)
+		}
+		pos++
+	})
+	if err != nil {
+		return -1, err
+	}
+	for t, ps := range found {
+		ix.terms[t] = append(ix.terms[t], Posting{doc, ps})
+	}
+	ix.docs = append(ix.docs, DocInfo{name, pos})
+	return doc, nil
+}
+
+// Docs returns the indexed documents; document i is Docs()[i].
+func (ix *Index) Docs() []DocInfo {
+	return ix.docs
+}
+
+// Terms returns every indexed term in sorted order.
+func (ix *Index) Terms() []string {
+	list := make([]string, 0, len(ix.terms))
+	for t := range ix.terms {
+		list = append(list, t)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Postings returns where term occurs, after folding it. The result is
+// shared with ix.
+func (ix *Index) Postings(term string) []Posting {
+	t, _ := ix.fold.word(term)
+	return ix.terms[t]
+}
+
+// Match is one document that satisfies a query, with the positions of
+// every query term that occurs in it. Terms that only appear under NOT are
+// not listed.
+type Match struct {
+	Doc       int
+	Name      string
+	Positions map[string][]int
+}
+
+// QueryError reports a malformed query. Offset is the byte offset in Query
+// where the problem was found.
+type QueryError struct {
+	Query  string
+	Offset int
+	Msg    string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("wordcount: query %q: %s at offset %d", e.Query, e.Msg, e.Offset)
+}
+
+// Query returns the documents matching q in document order.
+//
+// q is made of terms combined with the operators NOT, AND and OR, binding
+// in that order, and parentheses. Operators are recognized only in upper
+// case; two terms with no operator between them are ANDed:
+//
+//	gopher AND (donut OR bagel)
+//	gopher donut NOT bagel
+//
+// Every term must be a single word that the index would not drop as a
+// stopword, otherwise a *QueryError is returned.
+func (ix *Index) Query(q string) ([]Match, error) {
+	p := &queryParser{ix: ix, query: q, toks: lexQuery(q)}
+	n, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []string
+	n.positive(false, &terms)
+	var matches []Match
+	for _, doc := range n.eval(ix) {
+		m := Match{doc, ix.docs[doc].Name, make(map[string][]int)}
+		for _, t := range terms {
+			if ps := ix.positions(t, doc); ps != nil {
+				m.Positions[t] = ps
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches, nil
+}
+
+// positions returns where folded term t occurs in doc, or nil.
+func (ix *Index) positions(t string, doc int) []int {
+	list := ix.terms[t]
+	i := sort.Search(len(list), func(i int) bool { return list[i].Doc >= doc })
+	if i < len(list) && list[i].Doc == doc {
+		return list[i].Positions
+	}
+	return nil
+}
+
+// query AST
+
+type queryOp int
+
+const (
+	opTerm queryOp = iota
+	opNot
+	opAnd
+	opOr
+)
+
+type queryNode struct {
+	op   queryOp
+	term string // opTerm일 때만
+	kids []*queryNode
+}
+
+// eval returns the matching document numbers in ascending order.
+func (n *queryNode) eval(ix *Index) []int {
+	switch n.op {
+	case opTerm:
+		list := ix.terms[n.term]
+		docs := make([]int, len(list))
+		for i, p := range list {
+			docs[i] = p.Doc
+		}
+		return docs
+	case opNot:
+		return subtract(allDocs(len(ix.docs)), n.kids[0].eval(ix))
+	case opAnd:
+		docs := n.kids[0].eval(ix)
+		for _, k := range n.kids[1:] {
+			docs = intersect(docs, k.eval(ix))
+		}
+		return docs
+	default:
+		docs := n.kids[0].eval(ix)
+		for _, k := range n.kids[1:] {
+			docs = union(docs, k.eval(ix))
+		}
+		return docs
+	}
+}
+
+// positive appends the terms that are not negated, each once.
+func (n *queryNode) positive(negated bool, terms *[]string) {
+	switch n.op {
+	case opTerm:
+		if negated {
+			return
+		}
+		for _, t := range *terms {
+			if t == n.term {
+				return
+			}
+		}
+		*terms = append(*terms, n.term)
+	case opNot:
+		n.kids[0].positive(!negated, terms)
+	default:
+		for _, k := range n.kids {
+			k.positive(negated, terms)
+		}
+	}
+}
+
+// 정렬된 문서 번호 목록끼리의 집합 연산
+
+func allDocs(n int) []int {
+	docs := make([]int, n)
+	for i := range docs {
+		docs[i] = i
+	}
+	return docs
+}
+
+func intersect(a, b []int) []int {
+	var out []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+func union(a, b []int) []int {
+	out := make([]int, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			out = append(out, a[i])
+			i++
+		case a[i] > b[j]:
+			out = append(out, b[j])
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	out = append(out, a[i:]...)
+	return append(out, b[j:]...)
+}
+
+func subtract(a, b []int) []int {
+	var out []int
+	j := 0
+	for _, d := range a {
+		for j < len(b) && b[j] < d {
+			j++
+		}
+		if j == len(b) || b[j] != d {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// query parser
+
+type queryToken struct {
+	text   string
+	offset int
+}
+
+// lexQuery splits q into parentheses and runs of other non-space bytes.
+func lexQuery(q string) []queryToken {
+	var toks []queryToken
+	for i := 0; i < len(q); {
+		switch c := q[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			toks = append(toks, queryToken{q[i : i+1], i})
+			i++
+		default:
+			j := i
+			for j < len(q) && !strings.ContainsRune(" \t\n\r()", rune(q[j])) {
+				j++
+			}
+			toks = append(toks, queryToken{q[i:j], i})
+			i = j
+		}
+	}
+	return toks
+}
+
+// 문법 (NOT > AND > OR):
+//
+//	or    = and { "OR" and }
+//	and   = unary { ["AND"] unary }
+//	unary = "NOT" unary | "(" or ")" | term
+type queryParser struct {
+	ix    *Index
+	query string
+	toks  []queryToken
+	i     int
+}
+
+func (p *queryParser) parse() (*queryNode, error) {
+	if len(p.toks) == 0 {
+		return nil, p.errorf(0, "empty query")
+	}
+	n, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.i < len(p.toks) {
+		t := p.toks[p.i]
+		return nil, p.errorf(t.offset, "unexpected %q", t.text)
+	}
+	return n, nil
+}
+
+func (p *queryParser) peek() string {
+	if p.i < len(p.toks) {
+		return p.toks[p.i].text
+	}
+	return ""
+}
+
+func (p *queryParser) or() (*queryNode, error) {
+	n, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	kids := []*queryNode{n}
+	for p.peek() == "OR" {
+		p.i++
+		k, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		kids = append(kids, k)
+	}
+	if len(kids) == 1 {
+		return n, nil
+	}
+	return &queryNode{op: opOr, kids: kids}, nil
+}
+
+func (p *queryParser) and() (*queryNode, error) {
+	n, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	kids := []*queryNode{n}
+	for {
+		switch p.peek() {
+		case "AND":
+			p.i++
+		case "", "OR", ")":
+			if len(kids) == 1 {
+				return n, nil
+			}
+			return &queryNode{op: opAnd, kids: kids}, nil
+		}
+		k, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		kids = append(kids, k)
+	}
+}
+
+func (p *queryParser) unary() (*queryNode, error) {
+	if p.i == len(p.toks) {
+		return nil, p.errorf(len(p.query), "unexpected end of query")
+	}
+	t := p.toks[p.i]
+	p.i++
+	switch t.text {
+	case "NOT":
+		k, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNode{op: opNot, kids: []*queryNode{k}}, nil
+	case "(":
+		n, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			if p.i == len(p.toks) {
+				return nil, p.errorf(t.offset, "unclosed parenthesis")
+			}
+			u := p.toks[p.i]
+			return nil, p.errorf(u.offset, "unexpected %q", u.text)
+		}
+		p.i++
+		return n, nil
+	case ")", "AND", "OR":
+		return nil, p.errorf(t.offset, "unexpected %q", t.text)
+	}
+	return p.term(t)
+}
+
+// term turns t into a term node, folding it the way the documents were.
+func (p *queryParser) term(t queryToken) (*queryNode, error) {
+	words, _ := Words(strings.NewReader(t.text), KeepCase())
+	if len(words) != 1 {
+		return nil, p.errorf(t.offset, "%q is not a single word", t.text)
+	}
+	w, ok := p.ix.fold.word(words[0])
+	if !ok {
+		return nil, p.errorf(t.offset, "%q is a stopword and never indexed", t.text)
+	}
+	return &queryNode{op: opTerm, term: w}, nil
+}
+
+func (p *queryParser) errorf(offset int, format string, args ...any) error {
+	return &QueryError{p.query, offset, fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,302 @@
+package wordcount
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"sort"
+)
+
+// Index file format
+//
+// An Index is written as a byte stream in which every integer is an
+// unsigned varint (encoding/binary.PutUvarint) and every string is a
+// varint byte length followed by that many bytes of UTF-8:
+//
+//	magic      "WCIX"
+//	version    1 byte, currently 1
+//	flags      1 byte, bit 0 set if the index was built with KeepCase
+//	stopwords  count, then each stopword, sorted
+//	documents  count, then for each document its name and word count
+//	terms      count, then for each term, sorted:
+//	             the term
+//	             the number of postings, then for each posting:
+//	               the document number minus the previous one's (the first
+//	               is the number itself)
+//	               the number of positions, then each position minus the
+//	               previous one (the first is the position itself)
+//	checksum   4 bytes, big-endian CRC-32 (IEEE) of everything before it
+//
+// Sorting the terms and delta-encoding the numbers make the file small and
+// the same index always produce the same bytes.
+
+const (
+	indexMagic   = "WCIX"
+	indexVersion = 1
+
+	flagKeepCase = 1 << 0
+)
+
+// ErrIndexFormat is returned, wrapped, by ReadIndex when its input is not
+// a valid index file.
+var ErrIndexFormat = errors.New("wordcount: malformed index file")
+
+// WriteTo writes ix in the index file format and returns the number of
+// bytes written. It implements io.WriterTo.
+func (ix *Index) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	h := crc32.NewIEEE()
+	e := &indexEncoder{w: io.MultiWriter(bw, h)}
+
+	e.bytes([]byte(indexMagic))
+	var flags byte
+	if ix.fold.keepCase {
+		flags |= flagKeepCase
+	}
+	e.bytes([]byte{indexVersion, flags})
+
+	stop := make([]string, 0, len(ix.fold.stop))
+	for s := range ix.fold.stop {
+		stop = append(stop, s)
+	}
+	sort.Strings(stop)
+	e.uint(len(stop))
+	for _, s := range stop {
+		e.string(s)
+	}
+
+	e.uint(len(ix.docs))
+	for _, d := range ix.docs {
+		e.string(d.Name)
+		e.uint(d.Words)
+	}
+
+	terms := ix.Terms()
+	e.uint(len(terms))
+	for _, t := range terms {
+		e.string(t)
+		list := ix.terms[t]
+		e.uint(len(list))
+		prevDoc := 0
+		for _, p := range list {
+			e.uint(p.Doc - prevDoc)
+			prevDoc = p.Doc
+			e.uint(len(p.Positions))
+			prevPos := 0
+			for _, pos := range p.Positions {
+				e.uint(pos - prevPos)
+				prevPos = pos
+			}
+		}
+	}
+
+	if e.err == nil {
+		_, e.err = bw.Write(h.Sum(nil))
+	}
+	if e.err == nil {
+		e.err = bw.Flush()
+	}
+	return cw.n, e.err
+}
+
+type indexEncoder struct {
+	w   io.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (e *indexEncoder) bytes(b []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(b)
+	}
+}
+
+func (e *indexEncoder) uint(v int) {
+	e.bytes(e.buf[:binary.PutUvarint(e.buf[:], uint64(v))])
+}
+
+func (e *indexEncoder) string(s string) {
+	e.uint(len(s))
+	e.bytes([]byte(s))
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
+
+// ReadIndex reads an index written by Index.WriteTo. Queries on the
+// result fold and drop words exactly like the index that was written.
+// Bytes after the checksum are left unread only if r is an io.ByteReader;
+// otherwise some may have been buffered.
+func ReadIndex(r io.Reader) (*Index, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	d := &indexDecoder{r: br, h: crc32.NewIEEE()}
+
+	if magic := d.bytes(len(indexMagic)); d.err == nil && string(magic) != indexMagic {
+		return nil, fmt.Errorf("%w: bad magic %q", ErrIndexFormat, magic)
+	}
+	head := d.bytes(2)
+	if d.err == nil && head[0] != indexVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrIndexFormat, head[0])
+	}
+	if d.err == nil && head[1]&^flagKeepCase != 0 {
+		return nil, fmt.Errorf("%w: unknown flags %#x", ErrIndexFormat, head[1])
+	}
+
+	var opts []Option
+	if d.err == nil && head[1]&flagKeepCase != 0 {
+		opts = append(opts, KeepCase())
+	}
+	ix := NewIndex(opts...)
+	for i, n := 0, d.uint(); i < n && d.err == nil; i++ {
+		ix.fold.stop[d.string()] = true
+	}
+
+	for i, n := 0, d.uint(); i < n && d.err == nil; i++ {
+		ix.docs = append(ix.docs, DocInfo{d.string(), d.uint()})
+	}
+
+	prevTerm := ""
+	for i, n := 0, d.uint(); i < n && d.err == nil; i++ {
+		t := d.string()
+		if i > 0 && t <= prevTerm {
+			d.fail("terms out of order at %q", t)
+		}
+		prevTerm = t
+		var list []Posting
+		doc := 0
+		for j, np := 0, d.uint(); j < np && d.err == nil; j++ {
+			delta := d.uint()
+			if j > 0 && delta == 0 {
+				d.fail("duplicate document in postings of %q", t)
+			}
+			doc += delta
+			if doc >= len(ix.docs) {
+				d.fail("document %d out of range in postings of %q", doc, t)
+				break
+			}
+			nw := ix.docs[doc].Words
+			var ps []int
+			pos := 0
+			for k, npos := 0, d.uint(); k < npos && d.err == nil; k++ {
+				step := d.uint()
+				if k > 0 && step == 0 {
+					d.fail("duplicate position in postings of %q", t)
+				}
+				pos += step
+				if pos >= nw {
+					d.fail("position %d out of range in postings of %q", pos, t)
+				}
+				ps = append(ps, pos)
+			}
+			if len(ps) == 0 {
+				d.fail("empty posting for %q", t)
+			}
+			list = append(list, Posting{doc, ps})
+		}
+		if d.err == nil && len(list) == 0 {
+			d.fail("no postings for %q", t)
+		}
+		ix.terms[t] = list
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	sum := d.h.Sum32()
+	var tail [4]byte
+	for i := range tail {
+		c, err := br.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: missing checksum", ErrIndexFormat)
+		}
+		tail[i] = c
+	}
+	if binary.BigEndian.Uint32(tail[:]) != sum {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrIndexFormat)
+	}
+	return ix, nil
+}
+
+// maxIndexString bounds the strings ReadIndex accepts, so a corrupt length
+// cannot make it allocate gigabytes.
+const maxIndexString = 1 << 20
+
+// indexDecoder reads the varints and strings of an index file, feeding
+// every byte to h. After the first error every read returns zero values
+// and err keeps that error.
+type indexDecoder struct {
+	r   io.ByteReader
+	h   hash.Hash32
+	err error
+}
+
+func (d *indexDecoder) fail(format string, args ...any) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %s", ErrIndexFormat, fmt.Sprintf(format, args...))
+	}
+}
+
+func (d *indexDecoder) ReadByte() (byte, error) {
+	c, err := d.r.ReadByte()
+	if err == nil {
+		d.h.Write([]byte{c})
+	}
+	return c, err
+}
+
+func (d *indexDecoder) bytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		if d.err != nil {
+			return b
+		}
+		c, err := d.ReadByte()
+		if err != nil {
+			d.fail("unexpected end of file")
+			return b
+		}
+		b[i] = c
+	}
+	return b
+}
+
+func (d *indexDecoder) uint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(d)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		d.fail("unexpected end of file")
+		return 0
+	}
+	if err != nil || v > 1<<31 {
+		d.fail("bad number")
+		return 0
+	}
+	return int(v)
+}
+
+func (d *indexDecoder) string() string {
+	n := d.uint()
+	if n > maxIndexString {
+		d.fail("string of %d bytes", n)
+		return ""
+	}
+	return string(d.bytes(n))
+}
@@ -0,0 +1,211 @@
+package wordcount
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func newTestIndex(t *testing.T) *Index {
+	t.Helper()
+	ix := NewIndex(WithStopwords(EnglishStopwords...))
+	for i, d := range corpusDocs {
+		if n, err := ix.Add(d.name, strings.NewReader(d.text)); n != i || err != nil {
+			t.Fatalf("Add(%s) = %d, %v", d.name, n, err)
+		}
+	}
+	return ix
+}
+
+func TestIndex(t *testing.T) {
+	ix := newTestIndex(t)
+	docs := []DocInfo{{"donut", 9}, {"bagel", 7}, {"gopher", 8}, {"empty", 0}}
+	if !reflect.DeepEqual(ix.Docs(), docs) {
+		t.Errorf("Docs = %v, want %v", ix.Docs(), docs)
+	}
+	terms := strings.Fields("another ate bagel don't donut donuts eat gopher gophers not nothing")
+	if !reflect.DeepEqual(ix.Terms(), terms) {
+		t.Errorf("Terms = %q, want %q", ix.Terms(), terms)
+	}
+	postings := []Posting{{0, []int{3, 8}}, {1, []int{6}}}
+	if got := ix.Postings("Donut"); !reflect.DeepEqual(got, postings) {
+		t.Errorf("Postings(Donut) = %v, want %v", got, postings)
+	}
+}
+
+type pos = map[string][]int
+
+var queryTests = []struct {
+	q    string
+	want []Match
+}{
+	{"donut", []Match{{0, "donut", pos{"donut": {3, 8}}}, {1, "bagel", pos{"donut": {6}}}}},
+	{"ate AND donut", []Match{
+		{0, "donut", pos{"ate": {1, 6}, "donut": {3, 8}}},
+		{1, "bagel", pos{"ate": {1}, "donut": {6}}},
+	}},
+	{"ate donut", []Match{
+		{0, "donut", pos{"ate": {1, 6}, "donut": {3, 8}}},
+		{1, "bagel", pos{"ate": {1}, "donut": {6}}},
+	}},
+	{"donut OR gopher", []Match{
+		{0, "donut", pos{"donut": {3, 8}}},
+		{1, "bagel", pos{"donut": {6}}},
+		{2, "gopher", pos{"gopher": {1}}},
+	}},
+	{"NOT donut", []Match{{2, "gopher", pos{}}, {3, "empty", pos{}}}},
+	{"ate NOT donut", []Match{{2, "gopher", pos{"ate": {2}}}}},
+	{"ate AND NOT (bagel OR gopher)", []Match{{0, "donut", pos{"ate": {1, 6}}}}},
+	{"(donut OR nothing) AND NOT another", []Match{
+		{1, "bagel", pos{"donut": {6}}},
+		{2, "gopher", pos{"nothing": {3}}},
+	}},
+	{"NOT NOT bagel", []Match{{1, "bagel", pos{"bagel": {3}}}}},
+	{"don't", []Match{{2, "gopher", pos{"don't": {5}}}}},
+	{"missing OR bagel", []Match{{1, "bagel", pos{"bagel": {3}}}}},
+	{"missing", nil},
+}
+
+func TestQuery(t *testing.T) {
+	ix := newTestIndex(t)
+	for _, c := range queryTests {
+		got, err := ix.Query(c.q)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Query(%q) = %v, %v, want %v", c.q, got, err, c.want)
+		}
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	ix := newTestIndex(t)
+	for _, c := range []struct {
+		q      string
+		offset int
+		msg    string
+	}{
+		{"", 0, "empty query"},
+		{"donut AND", 9, "unexpected end of query"},
+		{"(donut OR bagel", 0, "unclosed parenthesis"},
+		{"donut)", 5, `unexpected ")"`},
+		{"OR bagel", 0, `unexpected "OR"`},
+		{"the donut", 0, `"the" is a stopword and never indexed`},
+		{"a-b", 0, `"a-b" is not a single word`},
+		{"...", 0, `"..." is not a single word`},
+	} {
+		_, err := ix.Query(c.q)
+		var qe *QueryError
+		if !errors.As(err, &qe) {
+			t.Errorf("Query(%q): err = %v, want a *QueryError", c.q, err)
+			continue
+		}
+		want := QueryError{c.q, c.offset, c.msg}
+		if *qe != want {
+			t.Errorf("Query(%q): err = %+v, want %+v", c.q, *qe, want)
+		}
+	}
+}
+
+// 파일로 썼다가 다시 읽어도 같은 답을 내야 하고, 다시 쓰면 같은 byte가 나와야 한다.
+func TestIndexFile(t *testing.T) {
+	ix := newTestIndex(t)
+	var buf bytes.Buffer
+	n, err := ix.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		t.Fatalf("WriteTo = %d, %v; wrote %d bytes", n, err, buf.Len())
+	}
+	data := buf.Bytes()
+
+	for _, r := range []struct {
+		name string
+		wrap func(io.Reader) io.Reader
+	}{
+		{"plain", func(r io.Reader) io.Reader { return r }},
+		{"OneByteReader", iotest.OneByteReader},
+	} {
+		back, err := ReadIndex(r.wrap(bytes.NewReader(data)))
+		if err != nil {
+			t.Errorf("ReadIndex via %s: %v", r.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(back.Docs(), ix.Docs()) || !reflect.DeepEqual(back.Terms(), ix.Terms()) {
+			t.Errorf("via %s: docs %v terms %q, want %v %q", r.name, back.Docs(), back.Terms(), ix.Docs(), ix.Terms())
+		}
+		for _, c := range queryTests {
+			got, err := back.Query(c.q)
+			if err != nil || !reflect.DeepEqual(got, c.want) {
+				t.Errorf("via %s: Query(%q) = %v, %v, want %v", r.name, c.q, got, err, c.want)
+			}
+		}
+		// stopword 설정도 파일에 들어 있다
+		if _, err := back.Query("the"); err == nil {
+			t.Errorf("via %s: stopword query accepted", r.name)
+		}
+		var again bytes.Buffer
+		if _, err := back.WriteTo(&again); err != nil || !bytes.Equal(again.Bytes(), data) {
+			t.Errorf("via %s: written again differs (err %v)", r.name, err)
+		}
+	}
+}
+
+func TestIndexFileKeepCase(t *testing.T) {
+	ix := NewIndex(KeepCase())
+	ix.Add("case", strings.NewReader("Go go GO"))
+	var buf bytes.Buffer
+	if _, err := ix.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	back, err := ReadIndex(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for q, want := range map[string][]Match{
+		"Go": {{0, "case", pos{"Go": {0}}}},
+		"go": {{0, "case", pos{"go": {1}}}},
+	} {
+		if got, err := back.Query(q); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Query(%q) = %v, %v, want %v", q, got, err, want)
+		}
+	}
+}
+
+func TestReadIndexErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := newTestIndex(t).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	flip := func(i int) []byte {
+		b := bytes.Clone(data)
+		b[i] ^= 0x40
+		return b
+	}
+	for _, c := range []struct {
+		name string
+		data []byte
+		msg  string
+	}{
+		{"empty", nil, "unexpected end of file"},
+		{"magic", flip(0), `bad magic "\x17CIX"`},
+		{"version", flip(4), "unsupported version 65"},
+		{"flags", flip(5), "unknown flags 0x40"},
+		{"truncated", data[:len(data)/2], "unexpected end of file"},
+		{"no checksum", data[:len(data)-4], "missing checksum"},
+		{"checksum", flip(len(data) - 1), "checksum mismatch"},
+		{"body", flip(len(data) - 8), `document 12 out of range in postings of "nothing"`},
+		{"huge string", append([]byte("WCIX\x01\x00\x01"), 0xff, 0xff, 0xff, 0x0f), "string of 33554431 bytes"},
+		{"doc out of range", []byte("WCIX\x01\x00\x00\x00\x01\x01x\x01\x01\x05\x00\x00"), `document 1 out of range in postings of "x"`},
+	} {
+		ix, err := ReadIndex(bytes.NewReader(c.data))
+		if ix != nil || !errors.Is(err, ErrIndexFormat) {
+			t.Errorf("%s: ReadIndex = %v, %v, want ErrIndexFormat", c.name, ix, err)
+			continue
+		}
+		if want := ErrIndexFormat.Error() + ": " + c.msg; err.Error() != want {
+			t.Errorf("%s: err = %q, want %q", c.name, err, want)
+		}
+	}
+}
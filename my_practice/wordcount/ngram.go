@@ -0,0 +1,79 @@
+package wordcount
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// N-grams
+
+// n-gram은 연속한 n개의 단어. "I ate a donut"의 bigram은 "i ate", "ate a", "a donut".
+// 단어는 Counter와 똑같이 나누고 fold하며, stopword는 먼저 빼고 창을 민다.
+// 그래서 stopword를 쓰면 "ate donut"처럼 원문에서 떨어진 단어가 붙을 수 있다.
+
+// NGramSep joins the words of an n-gram.
+const NGramSep = " "
+
+// Words returns the words of r in order, split, folded and filtered the way
+// a Counter built with the same options would count them.
+func Words(r io.Reader, opts ...Option) ([]string, error) {
+	c := New(opts...)
+	var words []string
+	_, err := scan(r, func(word string) {
+		if w, ok := c.word(word); ok {
+			words = append(words, w)
+		}
+	})
+	return words, err
+}
+
+// NGrams returns every run of n consecutive words, joined by NGramSep.
+// It returns nil if there are fewer than n words, and an error if n < 1.
+func NGrams(words []string, n int) ([]string, error) {
+	if err := checkN(n); err != nil {
+		return nil, err
+	}
+	if len(words) < n {
+		return nil, nil
+	}
+	grams := make([]string, 0, len(words)-n+1)
+	for i := n; i <= len(words); i++ {
+		grams = append(grams, strings.Join(words[i-n:i], NGramSep))
+	}
+	return grams, nil
+}
+
+func checkN(n int) error {
+	if n < 1 {
+		return fmt.Errorf("wordcount: n-gram length %d, want at least 1", n)
+	}
+	return nil
+}
+
+// CountNGrams counts the n-grams of r. Only the last n words are kept in
+// memory, so r can be as long as ReadFrom allows. Total of the result is
+// the number of n-grams, and Top works on them like on single words.
+// CountNGrams(r, 1, opts...) counts the same as Count(r, opts...).
+func CountNGrams(r io.Reader, n int, opts ...Option) (*Counter, error) {
+	if err := checkN(n); err != nil {
+		return nil, err
+	}
+	c := New(opts...)
+	window := make([]string, 0, n)
+	_, err := scan(r, func(word string) {
+		w, ok := c.word(word)
+		if !ok {
+			return
+		}
+		if len(window) == n {
+			copy(window, window[1:])
+			window = window[:n-1]
+		}
+		window = append(window, w)
+		if len(window) == n {
+			c.add(strings.Join(window, NGramSep))
+		}
+	})
+	return c, err
+}
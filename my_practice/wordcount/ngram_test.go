@@ -0,0 +1,87 @@
+package wordcount
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const bagels = "I ate a donut. Then I ate another donut. I ate a bagel."
+
+func TestWords(t *testing.T) {
+	got, err := Words(strings.NewReader(bagels))
+	want := strings.Fields("i ate a donut then i ate another donut i ate a bagel")
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Words = %q, %v, want %q", got, err, want)
+	}
+	got, _ = Words(strings.NewReader(bagels), WithStopwords(EnglishStopwords...))
+	want = strings.Fields("ate donut ate another donut ate bagel")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Words with stopwords = %q, want %q", got, want)
+	}
+}
+
+func TestNGrams(t *testing.T) {
+	words := []string{"i", "ate", "a", "donut"}
+	for _, c := range []struct {
+		n    int
+		want []string
+	}{
+		{1, []string{"i", "ate", "a", "donut"}},
+		{2, []string{"i ate", "ate a", "a donut"}},
+		{3, []string{"i ate a", "ate a donut"}},
+		{4, []string{"i ate a donut"}},
+		{5, nil},
+	} {
+		got, err := NGrams(words, c.n)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("NGrams(%d) = %q, %v, want %q", c.n, got, err, c.want)
+		}
+	}
+	for _, n := range []int{0, -1} {
+		if got, err := NGrams(words, n); got != nil || err == nil {
+			t.Errorf("NGrams(%d) = %q, %v, want an error", n, got, err)
+		}
+	}
+}
+
+func TestCountNGrams(t *testing.T) {
+	for _, c := range []struct {
+		n     int
+		opts  []Option
+		total int
+		top   []Entry
+	}{
+		{1, nil, 13, []Entry{{"ate", 3}, {"i", 3}, {"a", 2}, {"donut", 2}}},
+		{2, nil, 12, []Entry{{"i ate", 3}, {"ate a", 2}, {"a bagel", 1}, {"a donut", 1}}},
+		{3, nil, 11, []Entry{{"i ate a", 2}, {"a donut then", 1}, {"another donut i", 1}, {"ate a bagel", 1}}},
+		// stopword를 먼저 빼므로 원문에서 떨어진 "donut ate"가 붙는다
+		{2, []Option{WithStopwords(EnglishStopwords...)}, 6,
+			[]Entry{{"donut ate", 2}, {"another donut", 1}, {"ate another", 1}, {"ate bagel", 1}}},
+	} {
+		got, err := CountNGrams(strings.NewReader(bagels), c.n, c.opts...)
+		if err != nil {
+			t.Errorf("CountNGrams(%d): %v", c.n, err)
+			continue
+		}
+		if got.Total() != c.total || !reflect.DeepEqual(got.Top(4), c.top) {
+			t.Errorf("CountNGrams(%d) = %d %v, want %d %v", c.n, got.Total(), got.Top(4), c.total, c.top)
+		}
+
+		// 흘려가며 센 것이 Words와 NGrams로 한꺼번에 나눈 것과 같아야 한다
+		words, _ := Words(strings.NewReader(bagels), c.opts...)
+		grams, _ := NGrams(words, c.n)
+		all := make(map[string]int)
+		for _, g := range grams {
+			all[g]++
+		}
+		if !maps.Equal(got.Counts(), all) {
+			t.Errorf("CountNGrams(%d) = %v, NGrams gives %v", c.n, got.Counts(), all)
+		}
+	}
+
+	if _, err := CountNGrams(strings.NewReader(bagels), 0); err == nil {
+		t.Error("CountNGrams(0): no error")
+	}
+}
@@ -0,0 +1,191 @@
+package wordcount
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"unicode/utf8"
+)
+
+// Parallel counting
+
+// 여러 파일을 map-reduce로 센다.
+//   1. producer 하나가 파일을 순서대로 열어 ChunkSize 이하의 조각으로 자른다.
+//      단어를 끊는 rune 바로 뒤에서만 자르므로 단어가 두 조각에 걸치지 않는다.
+//   2. worker들이 각자 자기 Counter에 조각의 단어를 센다. (map)
+//   3. 끝나면 각 worker의 Counter를 channel로 보내고 하나로 합친다. (reduce)
+// 합계는 어떤 순서로 더해도 같고 Top은 정렬해서 돌려주므로, 결과는 항상 같다.
+
+// ChunkSize is the most bytes a work item holds.
+const ChunkSize = 256 * 1024
+
+// Source is one input for CountParallel. Open is called once, by the
+// producer goroutine, when the source's turn comes.
+type Source struct {
+	Name string
+	Open func() (io.ReadCloser, error)
+}
+
+// FileSource returns a Source that opens the file at path.
+func FileSource(path string) Source {
+	return Source{path, func() (io.ReadCloser, error) { return os.Open(path) }}
+}
+
+// CountFiles counts the words in every file with up to workers goroutines.
+func CountFiles(ctx context.Context, paths []string, workers int, opts ...Option) (*Counter, error) {
+	srcs := make([]Source, len(paths))
+	for i, p := range paths {
+		srcs[i] = FileSource(p)
+	}
+	return CountParallel(ctx, srcs, workers, opts...)
+}
+
+// CountParallel counts the words of every source. At most workers
+// goroutines tokenize at once (at least one), and at most 2*workers
+// chunks are buffered. The first error, or ctx being cancelled, stops
+// the whole pipeline and is returned.
+func CountParallel(ctx context.Context, srcs []Source, workers int, opts ...Option) (*Counter, error) {
+	workers = max(workers, 1)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		errOnce  sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	chunks := make(chan []byte, 2*workers)
+	go func() {
+		defer close(chunks)
+		for _, src := range srcs {
+			if err := produce(ctx, src, chunks); err != nil {
+				fail(err)
+				return
+			}
+		}
+	}()
+
+	results := make(chan *Counter, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := New(opts...)
+			for chunk := range chunks {
+				if ctx.Err() != nil {
+					continue // chunks가 닫힐 때까지 비워서 producer가 막히지 않게 한다
+				}
+				c.addChunk(chunk)
+			}
+			results <- c
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	total := New(opts...)
+	for c := range results {
+		total.Merge(c)
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return total, nil
+}
+
+// produce sends src in chunks of at most ChunkSize bytes that end right
+// after a separator (or at the end of the source), so no word is split
+// between chunks. Only a word longer than ChunkSize is cut, into pieces.
+func produce(ctx context.Context, src Source, chunks chan<- []byte) error {
+	rc, err := src.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var carry []byte // 지난 조각 끝에서 잘리다 만 단어
+	for {
+		chunk := make([]byte, ChunkSize)
+		n := copy(chunk, carry)
+		m, err := io.ReadFull(rc, chunk[n:])
+		chunk, carry = chunk[:n+m], nil
+		if err == nil {
+			// 꽉 찼으면 마지막 구분자 뒤에서 자르고 나머지는 다음 조각 앞에 붙인다
+			at := cut(chunk)
+			chunk, carry = chunk[:at:at], chunk[at:]
+		}
+		if len(chunk) > 0 {
+			select {
+			case chunks <- chunk:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return nil
+		default:
+			return fmt.Errorf("%s: %w", src.Name, err)
+		}
+	}
+}
+
+// cut returns where a full chunk should end: right after its last rune
+// that ScanWords always treats as a separator, which is neither part of a
+// word nor a joiner. Text without spaces, such as Chinese or Japanese, can
+// be cut after punctuation. A chunk without any separator is one long word
+// and is cut at its last rune boundary instead.
+func cut(chunk []byte) int {
+	for i := len(chunk); i > 0; {
+		r, w := utf8.DecodeLastRune(chunk[:i])
+		// 잘못된 byte 하나는 조각 끝에서 잘린 rune의 앞부분일 수도 있으므로 구분자로 보지 않는다
+		if !isWordRune(r) && !isJoiner(r) && !(r == utf8.RuneError && w == 1) {
+			return i
+		}
+		i -= w
+	}
+	for i := len(chunk) - 1; i > 0; i-- {
+		if utf8.RuneStart(chunk[i]) {
+			return i
+		}
+	}
+	return len(chunk)
+}
+
+// addChunk counts every word of a complete chunk.
+func (c *Counter) addChunk(data []byte) {
+	for len(data) > 0 {
+		adv, tok, _ := ScanWords(data, true)
+		if tok != nil {
+			c.Add(string(tok))
+		}
+		if adv == 0 {
+			return
+		}
+		data = data[adv:]
+	}
+}
+
+// Merge adds the counts of other into c. Both should have been created
+// with the same options; other's words are not folded or filtered again.
+func (c *Counter) Merge(other *Counter) {
+	for w, n := range other.counts {
+		c.counts[w] += n
+	}
+	c.total += other.total
+}
@@ -0,0 +1,147 @@
+package wordcount
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func stringSource(name, text string) Source {
+	return Source{name, func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(text)), nil
+	}}
+}
+
+// parallelSources는 여러 조각으로 나뉠 만큼 긴 글들과 그 글을 모두 이은 것을 돌려준다.
+func parallelSources() ([]Source, string) {
+	var srcs []Source
+	var all strings.Builder
+	for i, c := range countTests {
+		if c.text == "" {
+			continue
+		}
+		text := strings.Repeat(c.text+"\n", ChunkSize/len(c.text)+i*100)
+		all.WriteString(text)
+		srcs = append(srcs, stringSource(c.name, text))
+	}
+	return srcs, all.String()
+}
+
+func TestCountParallel(t *testing.T) {
+	srcs, all := parallelSources()
+	serial := New()
+	if _, err := serial.ReadFrom(strings.NewReader(all)); err != nil {
+		t.Fatal(err)
+	}
+	for _, workers := range []int{0, 1, 2, 4, 8} {
+		c, err := CountParallel(context.Background(), srcs, workers)
+		if err != nil {
+			t.Errorf("%d workers: %v", workers, err)
+			continue
+		}
+		if !reflect.DeepEqual(c.Counts(), serial.Counts()) || c.Total() != serial.Total() {
+			t.Errorf("%d workers: total %d, top %v; serial total %d, top %v",
+				workers, c.Total(), c.Top(3), serial.Total(), serial.Top(3))
+		}
+	}
+}
+
+func TestCountParallelErrors(t *testing.T) {
+	srcs, _ := parallelSources()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := CountParallel(ctx, srcs, 4); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled: err = %v, want %v", err, context.Canceled)
+	}
+
+	fire := errors.New("disk on fire")
+	broken := append(srcs[:1:1], Source{"broken", func() (io.ReadCloser, error) {
+		return io.NopCloser(iotest.ErrReader(fire)), nil
+	}})
+	_, err := CountParallel(context.Background(), broken, 4)
+	if !errors.Is(err, fire) || !strings.HasPrefix(err.Error(), "broken: ") {
+		t.Errorf("read error: err = %v, want broken: %v", err, fire)
+	}
+
+	_, err = CountFiles(context.Background(), []string{"testdata/does-not-exist"}, 2)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("missing file: err = %v, want %v", err, fs.ErrNotExist)
+	}
+}
+
+// ASCII 공백이 없는 글도 ChunkSize를 넘지 않게 자르고, 단어는 끊지 않는다.
+func TestProduce(t *testing.T) {
+	for _, c := range []struct {
+		name, text string
+		whole      bool // 단어가 조각 사이에 걸치지 않는지
+	}{
+		{"japanese", strings.Repeat("東京都と大阪府、京都府。", ChunkSize/20), true},
+		{"joiners", strings.Repeat("don't,l’homme,", ChunkSize/10), true},
+		{"spaces", strings.Repeat("word ", ChunkSize/2), true},
+		// ChunkSize보다 긴 단어 하나는 어쩔 수 없이 여러 조각으로 나뉜다
+		{"one word", strings.Repeat("가", ChunkSize), false},
+	} {
+		chunks := make(chan []byte, 1)
+		errc := make(chan error, 1)
+		go func() {
+			defer close(chunks)
+			errc <- produce(context.Background(), stringSource(c.name, c.text), chunks)
+		}()
+		var got bytes.Buffer
+		n := 0
+		for chunk := range chunks {
+			n++
+			if len(chunk) > ChunkSize {
+				t.Errorf("%s: chunk %d has %d bytes, more than ChunkSize", c.name, n, len(chunk))
+			}
+			got.Write(chunk)
+		}
+		if err := <-errc; err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+		if got.String() != c.text {
+			t.Errorf("%s: chunks joined differ from the source", c.name)
+		}
+		if n < 2 {
+			t.Errorf("%s: %d chunks, want the text split", c.name, n)
+		}
+		if !c.whole {
+			continue
+		}
+		serial := New()
+		serial.ReadFrom(strings.NewReader(c.text))
+		par, err := CountParallel(context.Background(), []Source{stringSource(c.name, c.text)}, 4)
+		if err != nil {
+			t.Errorf("%s: CountParallel: %v", c.name, err)
+		} else if !reflect.DeepEqual(par.Counts(), serial.Counts()) {
+			t.Errorf("%s: parallel %v, serial %v", c.name, par.Top(3), serial.Top(3))
+		}
+	}
+}
+
+func TestCut(t *testing.T) {
+	for _, c := range []struct {
+		chunk string
+		want  int
+	}{
+		{"ab cd", 3},
+		{"ab cd ", 6},
+		{"ab don't", 3},
+		{"都、京都", len("都、")},
+		{"abcd", 3},
+		{"ab가", 2},
+		// 끝에서 잘린 rune의 앞부분은 구분자가 아니다
+		{"ab \xea\xb0", 3},
+	} {
+		if got := cut([]byte(c.chunk)); got != c.want {
+			t.Errorf("cut(%q) = %d, want %d", c.chunk, got, c.want)
+		}
+	}
+}
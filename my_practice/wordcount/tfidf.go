@@ -0,0 +1,129 @@
+package wordcount
+
+import (
+	"io"
+	"math"
+	"sort"
+)
+
+// TF-IDF
+
+// tf(t, d)  = d에서 t가 나온 횟수 / d의 단어 수
+// idf(t)    = ln((1+N) / (1+df(t))) + 1   (N: 문서 수, df: t가 나온 문서 수)
+// tfidf     = tf * idf
+// idf에 1을 더해두면 모든 문서에 나오는 단어도 0점이 되지 않고, 분모의 1 덕분에
+// 한 번도 나오지 않은 단어를 물어봐도 0으로 나누지 않는다.
+
+// Document is the term counts of one document in a Corpus.
+type Document struct {
+	Name   string
+	Counts map[string]int
+	Total  int
+}
+
+// TF returns the term frequency of term in d: its count divided by the
+// number of words in d, or 0 for an empty document. term must already be
+// folded; use Corpus.TF to fold it on the way.
+func (d *Document) TF(term string) float64 {
+	if d.Total == 0 {
+		return 0
+	}
+	return float64(d.Counts[term]) / float64(d.Total)
+}
+
+// Corpus collects per-document term counts and the document frequency of
+// every term, for TF-IDF scoring.
+type Corpus struct {
+	opts []Option
+	fold *Counter // term을 Counter와 같은 규칙으로 fold하는 데만 쓴다
+	docs []*Document
+	df   map[string]int
+}
+
+// NewCorpus returns an empty Corpus. opts apply to every document.
+func NewCorpus(opts ...Option) *Corpus {
+	return &Corpus{opts: opts, fold: New(opts...), df: make(map[string]int)}
+}
+
+// Add counts the words of r as a new document and returns its index.
+// A document that fails to read is not added.
+func (c *Corpus) Add(name string, r io.Reader) (int, error) {
+	wc := New(c.opts...)
+	if _, err := wc.ReadFrom(r); err != nil {
+		return -1, err
+	}
+	for t := range wc.counts {
+		c.df[t]++
+	}
+	c.docs = append(c.docs, &Document{name, wc.counts, wc.total})
+	return len(c.docs) - 1, nil
+}
+
+// Len returns the number of documents.
+func (c *Corpus) Len() int {
+	return len(c.docs)
+}
+
+// Doc returns document i.
+func (c *Corpus) Doc(i int) *Document {
+	return c.docs[i]
+}
+
+// term folds t like the documents were folded; ok is false for a stopword.
+func (c *Corpus) term(t string) (string, bool) {
+	return c.fold.word(t)
+}
+
+// DF returns how many documents contain term.
+func (c *Corpus) DF(term string) int {
+	t, _ := c.term(term)
+	return c.df[t]
+}
+
+// TF returns the term frequency of term in document i.
+func (c *Corpus) TF(i int, term string) float64 {
+	t, _ := c.term(term)
+	return c.docs[i].TF(t)
+}
+
+// IDF returns the smoothed inverse document frequency of term, which is
+// at least 1 and is largest for terms that occur in no document.
+func (c *Corpus) IDF(term string) float64 {
+	return idf(len(c.docs), c.DF(term))
+}
+
+func idf(n, df int) float64 {
+	return math.Log(float64(1+n)/float64(1+df)) + 1
+}
+
+// TFIDF returns the TF-IDF score of term in document i.
+func (c *Corpus) TFIDF(i int, term string) float64 {
+	t, _ := c.term(term)
+	return c.docs[i].TF(t) * idf(len(c.docs), c.df[t])
+}
+
+// Score is a term and its TF-IDF score in one document.
+type Score struct {
+	Term  string
+	Score float64
+}
+
+// Top returns the k terms of document i with the highest TF-IDF score,
+// ties broken alphabetically. k <= 0 returns all of them.
+func (c *Corpus) Top(i, k int) []Score {
+	d := c.docs[i]
+	list := make([]Score, 0, len(d.Counts))
+	for t := range d.Counts {
+		list = append(list, Score{t, d.TF(t) * idf(len(c.docs), c.df[t])})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].Term < list[j].Term
+	})
+	if k > 0 && k < len(list) {
+		list = list[:k]
+	}
+	return list
+}
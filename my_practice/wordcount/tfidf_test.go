@@ -0,0 +1,79 @@
+package wordcount
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+var corpusDocs = []struct{ name, text string }{
+	{"donut", "I ate a donut. Then I ate another donut."},
+	{"bagel", "I ate a bagel, not a donut."},
+	{"gopher", "The gopher ate nothing. Gophers don't eat donuts."},
+	{"empty", ""},
+}
+
+func close4(a, b float64) bool {
+	return math.Abs(a-b) < 5e-5
+}
+
+func TestCorpus(t *testing.T) {
+	c := NewCorpus(WithStopwords(EnglishStopwords...))
+	for i, d := range corpusDocs {
+		if n, err := c.Add(d.name, strings.NewReader(d.text)); n != i || err != nil {
+			t.Fatalf("Add(%s) = %d, %v", d.name, n, err)
+		}
+	}
+	if c.Len() != 4 {
+		t.Fatalf("Len = %d, want 4", c.Len())
+	}
+
+	// idf = ln((1+N)/(1+df)) + 1, N = 4
+	for _, tt := range []struct {
+		term string
+		df   int
+		idf  float64
+	}{
+		{"ate", 3, 1.2231},
+		{"donut", 2, 1.5108},
+		{"DONUT", 2, 1.5108},
+		{"gopher", 1, 1.9163},
+		{"the", 0, 2.6094}, // stopword
+		{"missing", 0, 2.6094},
+	} {
+		if df, idf := c.DF(tt.term), c.IDF(tt.term); df != tt.df || !close4(idf, tt.idf) {
+			t.Errorf("%s: df %d idf %.4f, want df %d idf %.4f", tt.term, df, idf, tt.df, tt.idf)
+		}
+	}
+
+	for _, tt := range []struct {
+		doc       int
+		total     int
+		tf, tfidf float64
+		top       []Score
+	}{
+		{0, 5, 0.4, 0.6043, []Score{{"donut", 0.6043}, {"ate", 0.4893}, {"another", 0.3833}}},
+		{1, 4, 0.25, 0.3777, []Score{{"bagel", 0.4791}, {"not", 0.4791}, {"donut", 0.3777}}},
+		{2, 7, 0, 0, []Score{{"don't", 0.2738}, {"donuts", 0.2738}, {"eat", 0.2738}}},
+		{3, 0, 0, 0, nil},
+	} {
+		d := c.Doc(tt.doc)
+		if d.Name != corpusDocs[tt.doc].name || d.Total != tt.total {
+			t.Errorf("Doc(%d) = %s with %d words, want %s with %d", tt.doc, d.Name, d.Total, corpusDocs[tt.doc].name, tt.total)
+		}
+		if tf, tfidf := c.TF(tt.doc, "Donut"), c.TFIDF(tt.doc, "Donut"); !close4(tf, tt.tf) || !close4(tfidf, tt.tfidf) {
+			t.Errorf("%s: tf(donut) %.4f tfidf(donut) %.4f, want %.4f %.4f", d.Name, tf, tfidf, tt.tf, tt.tfidf)
+		}
+		top := c.Top(tt.doc, 3)
+		if len(top) != len(tt.top) {
+			t.Errorf("%s: Top(3) = %v, want %v", d.Name, top, tt.top)
+			continue
+		}
+		for i, s := range top {
+			if s.Term != tt.top[i].Term || !close4(s.Score, tt.top[i].Score) {
+				t.Errorf("%s: Top(3) = %v, want %v", d.Name, top, tt.top)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Package wordcount counts word frequencies in a stream of UTF-8 text.
+//
+// Unlike utils.WordCount3_23, which splits on whitespace only, a word here
+// is a run of letters, digits and combining marks in any script, so
+// "donut." and "donut" are the same word and Hangul such as "월드" is one
+// word. An apostrophe between two letters stays inside the word ("don't").
+// Words are case-folded by default, and text is read in chunks through a
+// bufio.Scanner, so the input never has to fit in memory.
+//
+// On top of the Counter the package counts n-grams (CountNGrams), scores
+// terms across documents by TF-IDF (Corpus) and builds an inverted index
+// with word positions that answers AND/OR/NOT queries and can be saved to
+// a file (Index).
+//
+// No Unicode normalization is done: a precomposed "café" and one spelled
+// with a combining accent are counted as different words.
+package wordcount
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Counter accumulates word counts. The zero value is not usable; use New.
+type Counter struct {
+	counts   map[string]int
+	total    int
+	stop     map[string]bool
+	keepCase bool
+}
+
+// Option configures a Counter.
+type Option func(*Counter)
+
+// WithStopwords skips the given words. They are folded the same way as the
+// text, so "The" also stops "the" unless KeepCase is set.
+func WithStopwords(words ...string) Option {
+	return func(c *Counter) {
+		for _, w := range words {
+			c.stop[w] = true
+		}
+	}
+}
+
+// KeepCase turns case folding off, so "Go" and "go" are counted apart.
+func KeepCase() Option {
+	return func(c *Counter) { c.keepCase = true }
+}
+
+// EnglishStopwords is a short list of common English function words for
+// use with WithStopwords.
+var EnglishStopwords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "from",
+	"has", "have", "he", "her", "his", "i", "in", "is", "it", "its", "of",
+	"on", "or", "she", "that", "the", "their", "then", "there", "they",
+	"this", "to", "was", "we", "were", "will", "with", "you",
+}
+
+// New returns an empty Counter.
+func New(opts ...Option) *Counter {
+	c := &Counter{counts: make(map[string]int), stop: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if !c.keepCase {
+		// stopword도 본문과 같은 방식으로 fold해둔다
+		folded := make(map[string]bool, len(c.stop))
+		for w := range c.stop {
+			folded[Fold(w)] = true
+		}
+		c.stop = folded
+	}
+	return c
+}
+
+// Add counts one occurrence of word, which is folded and checked against
+// the stopwords but not tokenized further.
+func (c *Counter) Add(word string) {
+	if w, ok := c.word(word); ok {
+		c.add(w)
+	}
+}
+
+// word folds word unless KeepCase is set and reports whether it should be
+// counted, i.e. it is neither empty nor a stopword.
+func (c *Counter) word(word string) (string, bool) {
+	if !c.keepCase {
+		word = Fold(word)
+	}
+	return word, word != "" && !c.stop[word]
+}
+
+// add counts key as is.
+func (c *Counter) add(key string) {
+	c.counts[key]++
+	c.total++
+}
+
+// ReadFrom counts every word in r. It implements io.ReaderFrom and returns
+// the number of bytes consumed.
+func (c *Counter) ReadFrom(r io.Reader) (int64, error) {
+	return scan(r, c.Add)
+}
+
+// scan calls fn for every word of r, before folding, and returns the
+// number of bytes consumed.
+func scan(r io.Reader, fn func(word string)) (int64, error) {
+	cr := &countingReader{r: r}
+	sc := bufio.NewScanner(cr)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	sc.Split(ScanWords)
+	for sc.Scan() {
+		fn(sc.Text())
+	}
+	return cr.n, sc.Err()
+}
+
+// Counts returns the counts so far. The map is shared with c.
+func (c *Counter) Counts() map[string]int {
+	return c.counts
+}
+
+// Total returns the number of words counted, stopwords excluded.
+func (c *Counter) Total() int {
+	return c.total
+}
+
+// Entry is a word and how many times it occurred.
+type Entry struct {
+	Word  string
+	Count int
+}
+
+// Top returns the k most frequent words, most frequent first, with ties
+// broken alphabetically so the result is deterministic. k <= 0 returns all.
+func (c *Counter) Top(k int) []Entry {
+	return TopK(c.counts, k)
+}
+
+// TopK is Top for any word-count map, such as one from WordCount3_23.
+func TopK(counts map[string]int, k int) []Entry {
+	list := make([]Entry, 0, len(counts))
+	for w, n := range counts {
+		list = append(list, Entry{w, n})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].Word < list[j].Word
+	})
+	if k > 0 && k < len(list) {
+		list = list[:k]
+	}
+	return list
+}
+
+// Count is a shortcut for New(opts...).ReadFrom(r) that returns the counts.
+func Count(r io.Reader, opts ...Option) (map[string]int, error) {
+	c := New(opts...)
+	_, err := c.ReadFrom(r)
+	return c.counts, err
+}
+
+// Fold case-folds s so that words differing only in case compare equal.
+// Mapping every rune through upper then lower case also folds variants
+// such as the Greek final sigma: "ΣΟΦΟΣ", "σοφος" and "σοφοσ" fold alike.
+func Fold(s string) string {
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(unicode.ToUpper(r))
+	}, s)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+}
+
+// isJoiner reports whether r is kept when it sits between two word runes.
+func isJoiner(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// ScanWords is a bufio.SplitFunc that yields the words described in the
+// package documentation and drops everything between them.
+func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	// 단어가 아닌 rune 건너뛰기
+	start := 0
+	for start < len(data) {
+		if !atEOF && !utf8.FullRune(data[start:]) {
+			return start, nil, nil
+		}
+		r, w := utf8.DecodeRune(data[start:])
+		if isWordRune(r) {
+			break
+		}
+		start += w
+	}
+
+	for i := start; i < len(data); {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			return start, nil, nil
+		}
+		r, w := utf8.DecodeRune(data[i:])
+		if isWordRune(r) {
+			i += w
+			continue
+		}
+		if isJoiner(r) {
+			// 다음 rune을 봐야 단어에 포함할지 알 수 있다
+			j := i + w
+			if j == len(data) && !atEOF || j < len(data) && !atEOF && !utf8.FullRune(data[j:]) {
+				return start, nil, nil
+			}
+			if j < len(data) {
+				if next, _ := utf8.DecodeRune(data[j:]); isWordRune(next) {
+					i = j
+					continue
+				}
+			}
+		}
+		return i + w, data[start:i], nil
+	}
+
+	if atEOF && start < len(data) {
+		return len(data), data[start:], nil
+	}
+	return start, nil, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(b []byte) (int, error) {
+	n, err := cr.r.Read(b)
+	cr.n += int64(n)
+	return n, err
+}
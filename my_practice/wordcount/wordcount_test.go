@@ -0,0 +1,124 @@
+package wordcount
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const tour = "I ate a donut. Then I ate another donut."
+
+var countTests = []struct {
+	name string
+	text string
+	opts []Option
+	want map[string]int
+}{
+	{"tour", tour, nil,
+		map[string]int{"ate": 2, "donut": 2, "i": 2, "a": 1, "another": 1, "then": 1}},
+	{"tour, keep case", tour, []Option{KeepCase()},
+		map[string]int{"I": 2, "ate": 2, "donut": 2, "Then": 1, "a": 1, "another": 1}},
+	{"tour, stopwords", tour, []Option{WithStopwords(EnglishStopwords...)},
+		map[string]int{"ate": 2, "donut": 2, "another": 1}},
+	{"stopwords are folded", "The the THE end", []Option{WithStopwords("The")},
+		map[string]int{"end": 1}},
+	{"apostrophes", "Don't stop—it's 'quoted' rock’n’roll, isn't it?", nil,
+		map[string]int{"don't": 1, "isn't": 1, "it": 1, "it's": 1, "quoted": 1, "rock’n’roll": 1, "stop": 1}},
+	{"hangul", "안녕, 월드! 월드는 넓다. Hello 월드!", nil,
+		map[string]int{"월드": 2, "hello": 1, "넓다": 1, "안녕": 1, "월드는": 1}},
+	{"greek", "ΣΟΦΟΣ σοφος σοφοσ", nil,
+		map[string]int{"σοφοσ": 3}},
+	// 정규화는 하지 않으므로 합쳐진 é와 e + 결합 accent는 다른 단어다
+	{"digits and marks", "Go 1.21 café café x2 x2", nil,
+		map[string]int{"x2": 2, "1": 1, "21": 1, "café": 1, "café": 1, "go": 1}},
+	{"empty", "", nil, map[string]int{}},
+	{"no words", " ... — !? ", nil, map[string]int{}},
+}
+
+func TestCount(t *testing.T) {
+	for _, c := range countTests {
+		total := 0
+		for _, n := range c.want {
+			total += n
+		}
+		// 한 번에 읽든 1 byte씩 읽든 결과가 같아야 한다
+		for _, slow := range []bool{false, true} {
+			r := strings.NewReader(c.text)
+			ctr := New(c.opts...)
+			var err error
+			if slow {
+				_, err = ctr.ReadFrom(iotest.OneByteReader(r))
+			} else {
+				_, err = ctr.ReadFrom(r)
+			}
+			if err != nil {
+				t.Errorf("%s (one byte %v): %v", c.name, slow, err)
+				continue
+			}
+			if !maps.Equal(ctr.Counts(), c.want) {
+				t.Errorf("%s (one byte %v): counts %v, want %v", c.name, slow, ctr.Counts(), c.want)
+			}
+			if ctr.Total() != total {
+				t.Errorf("%s (one byte %v): total %d, want %d", c.name, slow, ctr.Total(), total)
+			}
+		}
+	}
+}
+
+func TestReadFromBytes(t *testing.T) {
+	n, err := New().ReadFrom(strings.NewReader(tour))
+	if n != int64(len(tour)) || err != nil {
+		t.Errorf("ReadFrom = %d, %v, want %d, nil", n, err, len(tour))
+	}
+}
+
+func TestTop(t *testing.T) {
+	c := New()
+	if _, err := c.ReadFrom(strings.NewReader(strings.Repeat("b a c b c b ", 1000))); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		k    int
+		want []Entry
+	}{
+		{2, []Entry{{"b", 3000}, {"c", 2000}}},
+		{0, []Entry{{"b", 3000}, {"c", 2000}, {"a", 1000}}},
+		{-1, []Entry{{"b", 3000}, {"c", 2000}, {"a", 1000}}},
+		{5, []Entry{{"b", 3000}, {"c", 2000}, {"a", 1000}}},
+	} {
+		if got := c.Top(tt.k); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Top(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+
+	// 같은 횟수는 알파벳 순서
+	got := TopK(map[string]int{"pear": 1, "apple": 1, "fig": 2}, 0)
+	want := []Entry{{"fig", 2}, {"apple", 1}, {"pear", 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopK = %v, want %v", got, want)
+	}
+}
+
+func TestFold(t *testing.T) {
+	for _, s := range []string{"ΣΟΦΟΣ", "σοφος", "σοφοσ", "ΣοΦος"} {
+		if got := Fold(s); got != "σοφοσ" {
+			t.Errorf("Fold(%q) = %q, want %q", s, got, "σοφοσ")
+		}
+	}
+	if got := Fold("Go 월드"); got != "go 월드" {
+		t.Errorf("Fold(%q) = %q", "Go 월드", got)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	c := New(WithStopwords("the"))
+	for _, w := range []string{"Go", "go", "", "The", "two words"} {
+		c.Add(w)
+	}
+	want := map[string]int{"go": 2, "two words": 1}
+	if !maps.Equal(c.Counts(), want) || c.Total() != 3 {
+		t.Errorf("Add: counts %v total %d, want %v total 3", c.Counts(), c.Total(), want)
+	}
+}